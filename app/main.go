@@ -1,29 +1,61 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
+	"net"
+	"net/http"
 	"net/url"
 	"os"
+	"os/signal"
 	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/labstack/echo/v4"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/errgroup"
 
+	"github.com/bxcodec/go-clean-arch/internal/cache"
+	cachedRepo "github.com/bxcodec/go-clean-arch/internal/repository/cached"
 	mysqlRepo "github.com/bxcodec/go-clean-arch/internal/repository/mysql"
 
 	"github.com/bxcodec/go-clean-arch/article"
+	"github.com/bxcodec/go-clean-arch/auth"
+	"github.com/bxcodec/go-clean-arch/author"
 	"github.com/bxcodec/go-clean-arch/category"
+	"github.com/bxcodec/go-clean-arch/comment"
 	"github.com/bxcodec/go-clean-arch/internal/rest"
 	"github.com/bxcodec/go-clean-arch/internal/rest/middleware"
+	"github.com/bxcodec/go-clean-arch/internal/workers"
+	"github.com/bxcodec/go-clean-arch/like"
+	"github.com/bxcodec/go-clean-arch/tag"
+	"github.com/bxcodec/go-clean-arch/trash"
+	"github.com/bxcodec/go-clean-arch/view"
 	"github.com/joho/godotenv"
 )
 
 const (
-	defaultTimeout = 30
-	defaultAddress = ":9090"
+	defaultTimeout  = 30
+	defaultAddress  = ":9090"
+	defaultTokenTTL = 86400
+
+	defaultPublishInterval   = 60
+	defaultViewDedupWindow   = 1800
+	defaultViewFlushInterval = 10
+	defaultTrashRetention    = 30
+	defaultPurgeInterval     = 3600
+	defaultCacheControl      = "public, max-age=60"
+	defaultCacheTTL          = 60
+	defaultCacheSize         = 10000
+	defaultCategorySlug      = "uncategorized"
+	defaultCategoryName      = "Uncategorized"
+	shutdownTimeout          = 10 * time.Second
 )
 
 func init() {
@@ -63,6 +95,22 @@ func main() {
 	// prepare echo
 
 	e := echo.New()
+	// Client addresses only come from X-Forwarded-For when it is set by one of the trusted proxies,
+	// otherwise anyone could pick the address views and likes are deduplicated by
+	trustedProxies := os.Getenv("TRUSTED_PROXIES")
+	if trustedProxies == "" {
+		e.IPExtractor = echo.ExtractIPDirect()
+	} else {
+		trustOptions := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+		for _, cidr := range strings.Split(trustedProxies, ",") {
+			_, ipRange, err := net.ParseCIDR(strings.TrimSpace(cidr))
+			if err != nil {
+				log.Fatal("failed to parse trusted proxy range ", err)
+			}
+			trustOptions = append(trustOptions, echo.TrustIPRange(ipRange))
+		}
+		e.IPExtractor = echo.ExtractIPFromXFFHeader(trustOptions...)
+	}
 	e.Use(middleware.CORS)
 	timeoutStr := os.Getenv("CONTEXT_TIMEOUT")
 	timeout, err := strconv.Atoi(timeoutStr)
@@ -72,24 +120,163 @@ func main() {
 	}
 	timeoutContext := time.Duration(timeout) * time.Second
 	e.Use(middleware.SetRequestContextWithTimeout(timeoutContext))
+	cacheControl := os.Getenv("CACHE_CONTROL")
+	if cacheControl == "" {
+		cacheControl = defaultCacheControl
+	}
+	e.Use(middleware.CacheControl(cacheControl))
+
+	// prepare token signing
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		log.Fatal("JWT_SECRET must be set")
+	}
+	tokenTTLStr := os.Getenv("JWT_TTL")
+	tokenTTL, err := strconv.Atoi(tokenTTLStr)
+	if err != nil {
+		log.Println("failed to parse token TTL, using default token TTL")
+		tokenTTL = defaultTokenTTL
+	}
+
+	// Prepare the read-through cache, in memory unless a Redis server is given
+	var repoCache cache.Cache
+	if redisAddr := os.Getenv("REDIS_ADDRESS"); redisAddr != "" {
+		redisClient := redis.NewClient(&redis.Options{Addr: redisAddr, Password: os.Getenv("REDIS_PASSWORD")})
+		defer redisClient.Close()
+		repoCache = cache.NewRedis(redisClient)
+	} else {
+		cacheSizeStr := os.Getenv("CACHE_SIZE")
+		cacheSize, err := strconv.Atoi(cacheSizeStr)
+		if err != nil || cacheSize <= 0 {
+			log.Println("failed to parse cache size, using default cache size")
+			cacheSize = defaultCacheSize
+		}
+		repoCache = cache.NewLRU(cacheSize)
+	}
+	cacheTTLStr := os.Getenv("CACHE_TTL")
+	cacheTTL, err := strconv.Atoi(cacheTTLStr)
+	if err != nil || cacheTTL <= 0 {
+		log.Println("failed to parse cache TTL, using default cache TTL")
+		cacheTTL = defaultCacheTTL
+	}
+	cacheTTLDuration := time.Duration(cacheTTL) * time.Second
 
 	// Prepare Repository
-	authorRepo := mysqlRepo.NewAuthorRepository(dbConn)
-	articleRepo := mysqlRepo.NewArticleRepository(dbConn)
-	categoryRepo := mysqlRepo.NewCategoryRepository(dbConn)
+	authorRepo := cachedRepo.NewAuthorRepository(mysqlRepo.NewAuthorRepository(dbConn), repoCache, cacheTTLDuration)
+	articleRepo := cachedRepo.NewArticleRepository(mysqlRepo.NewArticleRepository(dbConn), repoCache, cacheTTLDuration)
+	categoryRepo := cachedRepo.NewCategoryRepository(mysqlRepo.NewCategoryRepository(dbConn), repoCache, cacheTTLDuration)
+	userRepo := mysqlRepo.NewUserRepository(dbConn)
+	commentRepo := mysqlRepo.NewCommentRepository(dbConn)
+	likeRepo := mysqlRepo.NewLikeRepository(dbConn)
+	tagRepo := cachedRepo.NewTagRepository(mysqlRepo.NewTagRepository(dbConn), repoCache, cacheTTLDuration)
 
 	// Build service Layer
-	articleSvc := article.NewService(articleRepo, authorRepo, categoryRepo)
-	categorySvc := category.NewService(categoryRepo)
+	categorySlug := os.Getenv("DEFAULT_CATEGORY_SLUG")
+	if categorySlug == "" {
+		categorySlug = defaultCategorySlug
+	}
+	categoryName := os.Getenv("DEFAULT_CATEGORY_NAME")
+	if categoryName == "" {
+		categoryName = defaultCategoryName
+	}
+	categorySvc := category.NewService(categoryRepo, categorySlug)
+	ensureCtx, cancelEnsure := context.WithTimeout(context.Background(), timeoutContext)
+	defaultCategory, err := categorySvc.EnsureDefault(ensureCtx, categoryName)
+	cancelEnsure()
+	if err != nil {
+		log.Fatal("failed to ensure the default category ", err)
+	}
+	articleSvc := article.NewService(articleRepo, authorRepo, categoryRepo, defaultCategory.ID)
+	authorSvc := author.NewService(authorRepo)
+	commentSvc := comment.NewService(commentRepo, articleRepo)
+	likeSvc := like.NewService(likeRepo, articleRepo)
+	tagSvc := tag.NewService(tagRepo)
+	trashSvc := trash.NewService(articleRepo, categoryRepo)
+	viewDedupWindowStr := os.Getenv("VIEW_DEDUP_WINDOW")
+	viewDedupWindow, err := strconv.Atoi(viewDedupWindowStr)
+	if err != nil || viewDedupWindow < 0 {
+		log.Println("failed to parse view dedup window, using default view dedup window")
+		viewDedupWindow = defaultViewDedupWindow
+	}
+	viewSvc := view.NewService(articleRepo, articleRepo, time.Duration(viewDedupWindow)*time.Second)
+	authSvc := auth.NewService(userRepo, []byte(jwtSecret), time.Duration(tokenTTL)*time.Second)
+
+	// Every write requires a valid bearer token, except signing in and reader interactions
+	e.Use(middleware.Authenticate(authSvc, "/auth/login", rest.CommentPostRoute, rest.ViewRoute, rest.LikeRoute))
 
 	// Register handlers
 	rest.NewArticleHandler(e, articleSvc)
 	rest.NewCategoryHandler(e, categorySvc)
+	rest.NewAuthorHandler(e, authorSvc)
+	rest.NewAuthHandler(e, authSvc)
+	rest.NewCommentHandler(e, commentSvc)
+	rest.NewViewHandler(e, viewSvc)
+	rest.NewLikeHandler(e, likeSvc)
+	rest.NewTagHandler(e, tagSvc)
+	rest.NewTrashHandler(e, trashSvc)
+
+	// Prepare background workers
+	publishIntervalStr := os.Getenv("PUBLISH_INTERVAL")
+	publishInterval, err := strconv.Atoi(publishIntervalStr)
+	if err != nil || publishInterval <= 0 {
+		log.Println("failed to parse publish interval, using default publish interval")
+		publishInterval = defaultPublishInterval
+	}
+	publisher := workers.NewPublisher(articleRepo, time.Duration(publishInterval)*time.Second)
+	viewFlushIntervalStr := os.Getenv("VIEW_FLUSH_INTERVAL")
+	viewFlushInterval, err := strconv.Atoi(viewFlushIntervalStr)
+	if err != nil || viewFlushInterval <= 0 {
+		log.Println("failed to parse view flush interval, using default view flush interval")
+		viewFlushInterval = defaultViewFlushInterval
+	}
+	viewFlusher := workers.NewViewFlusher(viewSvc, time.Duration(viewFlushInterval)*time.Second, shutdownTimeout)
+	trashRetentionStr := os.Getenv("TRASH_RETENTION_DAYS")
+	trashRetention, err := strconv.Atoi(trashRetentionStr)
+	if err != nil || trashRetention <= 0 {
+		log.Println("failed to parse trash retention, using default trash retention")
+		trashRetention = defaultTrashRetention
+	}
+	purgeIntervalStr := os.Getenv("PURGE_INTERVAL")
+	purgeInterval, err := strconv.Atoi(purgeIntervalStr)
+	if err != nil || purgeInterval <= 0 {
+		log.Println("failed to parse purge interval, using default purge interval")
+		purgeInterval = defaultPurgeInterval
+	}
+	purger := workers.NewPurger(trashSvc, time.Duration(purgeInterval)*time.Second, time.Duration(trashRetention)*24*time.Hour)
 
 	// Start Server
 	address := os.Getenv("SERVER_ADDRESS")
 	if address == "" {
 		address = defaultAddress
 	}
-	log.Fatal(e.Start(address)) //nolint
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		if err := e.Start(address); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	})
+	g.Go(func() error {
+		return publisher.Run(gctx)
+	})
+	g.Go(func() error {
+		return viewFlusher.Run(gctx)
+	})
+	g.Go(func() error {
+		return purger.Run(gctx)
+	})
+	g.Go(func() error {
+		<-gctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		return e.Shutdown(shutdownCtx)
+	})
+
+	if err := g.Wait(); err != nil {
+		log.Println("server stopped with error: ", err)
+	}
 }
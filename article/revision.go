@@ -0,0 +1,142 @@
+package article
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// FetchRevisions lists the revisions of an article, newest first
+func (a *Service) FetchRevisions(ctx context.Context, id uuid.UUID) ([]domain.ArticleRevision, error) {
+	if _, err := a.revisionsOf(ctx, id); err != nil {
+		return nil, err
+	}
+	return a.articleRepo.FetchRevisions(ctx, id)
+}
+
+// GetRevision returns one revision of an article
+func (a *Service) GetRevision(ctx context.Context, id uuid.UUID, revision int) (domain.ArticleRevision, error) {
+	if _, err := a.revisionsOf(ctx, id); err != nil {
+		return domain.ArticleRevision{}, err
+	}
+	return a.articleRepo.GetRevision(ctx, id, revision)
+}
+
+// DiffRevisions lists the fields changed between two revisions of an article
+func (a *Service) DiffRevisions(ctx context.Context, id uuid.UUID, from, to int) (domain.RevisionDiff, error) {
+	if _, err := a.revisionsOf(ctx, id); err != nil {
+		return domain.RevisionDiff{}, err
+	}
+
+	fromRev, err := a.articleRepo.GetRevision(ctx, id, from)
+	if err != nil {
+		return domain.RevisionDiff{}, err
+	}
+	toRev, err := a.articleRepo.GetRevision(ctx, id, to)
+	if err != nil {
+		return domain.RevisionDiff{}, err
+	}
+
+	return domain.RevisionDiff{From: from, To: to, Changes: diffRevisions(fromRev, toRev)}, nil
+}
+
+// RestoreRevision brings the content of an old revision back into the article.
+// Restoring saves the article again, so it adds a new revision instead of rewriting history.
+// Publish state and authorship are left as they are.
+func (a *Service) RestoreRevision(ctx context.Context, id uuid.UUID, revision int) error {
+	existing, err := a.revisionsOf(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	rev, err := a.articleRepo.GetRevision(ctx, id, revision)
+	if err != nil {
+		return err
+	}
+
+	restored := existing
+	restored.Title = rev.Title
+	restored.Slug = a.ensureUniqueSlug(ctx, rev.Slug, id)
+	restored.Content = rev.Content
+	restored.Thumbnail = rev.Thumbnail
+	restored.Image = rev.Image
+	restored.ShortDescription = rev.ShortDescription
+	restored.MetaDescription = rev.MetaDescription
+	restored.Keywords = rev.Keywords
+	restored.Tags = rev.Tags
+	restored.ReadingTimeMinutes = rev.ReadingTimeMinutes
+	restored.UpdatedAt = time.Now()
+
+	if err = authorizeWrite(ctx, &existing, restored); err != nil {
+		return err
+	}
+	return a.articleRepo.Update(ctx, &restored)
+}
+
+// revisionsOf returns the article when the user in ctx may see its history:
+// editors for every article, authors for their own
+func (a *Service) revisionsOf(ctx context.Context, id uuid.UUID) (domain.Article, error) {
+	user, err := domain.RequireRole(ctx, domain.RoleAuthor, domain.RoleEditor)
+	if err != nil {
+		return domain.Article{}, err
+	}
+
+	article, err := a.articleRepo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	if !user.HasRole(domain.RoleEditor) && !user.IsAuthorOf(article.Author.ID) {
+		return domain.Article{}, domain.ErrForbidden
+	}
+	return article, nil
+}
+
+// diffRevisions compares the editable fields of two revisions, in the order they appear in the revision
+func diffRevisions(from, to domain.ArticleRevision) []domain.FieldChange {
+	fields := []struct {
+		name     string
+		from, to interface{}
+	}{
+		{"title", from.Title, to.Title},
+		{"slug", from.Slug, to.Slug},
+		{"content", from.Content, to.Content},
+		{"thumbnail", from.Thumbnail, to.Thumbnail},
+		{"image", from.Image, to.Image},
+		{"short_description", from.ShortDescription, to.ShortDescription},
+		{"meta_description", from.MetaDescription, to.MetaDescription},
+		{"keywords", from.Keywords, to.Keywords},
+		{"tags", from.Tags, to.Tags},
+		{"reading_time_minutes", from.ReadingTimeMinutes, to.ReadingTimeMinutes},
+		{"published", from.Published, to.Published},
+		{"published_at", from.PublishedAt, to.PublishedAt},
+		{"author_id", from.AuthorID, to.AuthorID},
+	}
+
+	changes := make([]domain.FieldChange, 0)
+	for _, f := range fields {
+		if !sameValue(f.from, f.to) {
+			changes = append(changes, domain.FieldChange{Field: f.name, From: f.from, To: f.to})
+		}
+	}
+	return changes
+}
+
+func sameValue(a, b interface{}) bool {
+	switch a := a.(type) {
+	case *time.Time:
+		b := b.(*time.Time)
+		if a == nil || b == nil {
+			return a == b
+		}
+		return a.Equal(*b)
+	case domain.JSONStringSlice:
+		// No slice and an empty one are the same list
+		b := b.(domain.JSONStringSlice)
+		return len(a) == 0 && len(b) == 0 || reflect.DeepEqual(a, b)
+	}
+	return a == b
+}
@@ -0,0 +1,34 @@
+package article
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+func TestDiffRevisions(t *testing.T) {
+	publishedAt := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+	samePublishedAt := publishedAt.In(time.FixedZone("ICT", 7*3600))
+
+	from := domain.ArticleRevision{
+		Title:       "Hanoi in two days",
+		Content:     "<p>Old Quarter</p>",
+		Tags:        domain.JSONStringSlice{"vietnam"},
+		PublishedAt: &publishedAt,
+	}
+	to := from
+	to.Title = "Hanoi in three days"
+	to.Tags = domain.JSONStringSlice{"vietnam", "food"}
+	to.Keywords = domain.JSONStringSlice{}
+	to.PublishedAt = &samePublishedAt
+
+	assert.Equal(t, []domain.FieldChange{
+		{Field: "title", From: "Hanoi in two days", To: "Hanoi in three days"},
+		{Field: "tags", From: domain.JSONStringSlice{"vietnam"}, To: domain.JSONStringSlice{"vietnam", "food"}},
+	}, diffRevisions(from, to))
+
+	assert.Empty(t, diffRevisions(from, from))
+}
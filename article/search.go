@@ -0,0 +1,164 @@
+package article
+
+import (
+	"context"
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+const snippetRadius = 80
+
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// Search runs a full-text search over the articles and returns the matches ordered by relevance,
+// enriched like Fetch and with a highlighted snippet of the matching text
+func (a *Service) Search(ctx context.Context, q string, page, limit int) (res []domain.ArticleSearchResult, err error) {
+	q = strings.TrimSpace(q)
+	if q == "" {
+		return nil, domain.ErrBadParamInput
+	}
+
+	res, err = a.articleRepo.Search(ctx, q, a.visibleFilter(ctx, domain.ArticleFilter{}), page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	articles := make([]domain.Article, len(res))
+	for i := range res {
+		articles[i] = res[i].Article
+	}
+
+	articles, err = a.fillAuthorDetails(ctx, articles)
+	if err != nil {
+		return nil, err
+	}
+
+	responses, err := a.fillCategoriesAndBreadcrumb(ctx, articles)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := searchTerms(q)
+	for i := range res {
+		res[i].ArticleResponse = responses[i]
+		res[i].Snippet = highlightSnippet(plainText(responses[i].Content), terms, snippetRadius)
+		if res[i].Snippet == "" {
+			res[i].Snippet = highlightSnippet(responses[i].ShortDescription, terms, snippetRadius)
+		}
+	}
+
+	return res, nil
+}
+
+// searchTerms splits a search query into the lower-cased words worth highlighting
+func searchTerms(q string) []string {
+	var terms []string
+	for _, field := range strings.Fields(strings.ToLower(q)) {
+		term := strings.TrimFunc(field, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		if len([]rune(term)) > 1 {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// plainText strips the HTML markup of article content
+func plainText(content string) string {
+	text := html.UnescapeString(htmlTagPattern.ReplaceAllString(content, " "))
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// highlightSnippet cuts a window of radius runes around the first term found in text
+// and wraps every term occurrence inside it with <mark>. The rest of the snippet is HTML-escaped.
+// It returns an empty string when none of the terms occur in text.
+func highlightSnippet(text string, terms []string, radius int) string {
+	runes := []rune(text)
+	lower := []rune(strings.ToLower(text))
+	if len(lower) != len(runes) {
+		// Lower-casing changed the text length, fall back to matching the text as is
+		lower = runes
+	}
+
+	first, firstLen := -1, 0
+	for _, term := range terms {
+		t := []rune(term)
+		if idx := indexRunes(lower, t); idx >= 0 && (first < 0 || idx < first) {
+			first, firstLen = idx, len(t)
+		}
+	}
+	if first < 0 {
+		return ""
+	}
+
+	start := first - radius
+	if start < 0 {
+		start = 0
+	}
+	end := first + firstLen + radius
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	// Don't cut words in half at the edges of the window
+	for start > 0 && !unicode.IsSpace(runes[start-1]) {
+		start--
+	}
+	for end < len(runes) && !unicode.IsSpace(runes[end]) {
+		end++
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	for i := start; i < end; {
+		matched := 0
+		for _, term := range terms {
+			t := []rune(term)
+			if i+len(t) <= end && hasPrefixRunes(lower[i:], t) && len(t) > matched {
+				matched = len(t)
+			}
+		}
+		if matched > 0 {
+			b.WriteString("<mark>")
+			b.WriteString(html.EscapeString(string(runes[i : i+matched])))
+			b.WriteString("</mark>")
+			i += matched
+			continue
+		}
+		b.WriteString(html.EscapeString(string(runes[i])))
+		i++
+	}
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+
+	return b.String()
+}
+
+func indexRunes(s, sub []rune) int {
+	for i := 0; i+len(sub) <= len(s); i++ {
+		if hasPrefixRunes(s[i:], sub) {
+			return i
+		}
+	}
+	return -1
+}
+
+func hasPrefixRunes(s, prefix []rune) bool {
+	if len(prefix) > len(s) {
+		return false
+	}
+	for i := range prefix {
+		if s[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
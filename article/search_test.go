@@ -0,0 +1,47 @@
+package article
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTerms(t *testing.T) {
+	assert.Equal(t, []string{"dubai", "hotels"}, searchTerms(`  "Dubai" a HOTELS! `))
+}
+
+func TestHighlightSnippet(t *testing.T) {
+	text := plainText(`<p>Visiting Dubai &amp; Abu Dhabi</p><p>The best <b>hotels</b> in Dubai for families</p>`)
+
+	tests := []struct {
+		name   string
+		terms  []string
+		radius int
+		want   string
+	}{
+		{
+			name:   "marks every term in the window",
+			terms:  []string{"dubai", "hotels"},
+			radius: 200,
+			want:   "Visiting <mark>Dubai</mark> &amp; Abu Dhabi The best <mark>hotels</mark> in <mark>Dubai</mark> for families",
+		},
+		{
+			name:   "cuts around the first match on word boundaries",
+			terms:  []string{"hotels"},
+			radius: 5,
+			want:   "…best <mark>hotels</mark> in Dubai…",
+		},
+		{
+			name:   "no match",
+			terms:  []string{"paris"},
+			radius: 200,
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, highlightSnippet(text, tt.terms, tt.radius))
+		})
+	}
+}
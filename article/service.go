@@ -3,27 +3,30 @@ package article
 import (
 	"context"
 	"fmt"
-	"regexp"
-	"strings"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/bxcodec/go-clean-arch/domain"
+	"github.com/bxcodec/go-clean-arch/internal/slug"
 )
 
 // ArticleRepository represent the article's repository contract
 //
 //go:generate mockery --name ArticleRepository
 type ArticleRepository interface {
-	Fetch(ctx context.Context, page, limit int) (res []domain.Article, err error)
+	Fetch(ctx context.Context, filter domain.ArticleFilter, page, limit int) (res []domain.Article, err error)
+	FetchByCursor(ctx context.Context, filter domain.ArticleFilter, cursor string, limit int) (res []domain.Article, page domain.PageInfo, err error)
+	Count(ctx context.Context, filter domain.ArticleFilter) (int, error)
+	FetchRevisions(ctx context.Context, articleID uuid.UUID) ([]domain.ArticleRevision, error)
+	GetRevision(ctx context.Context, articleID uuid.UUID, revision int) (domain.ArticleRevision, error)
+	Search(ctx context.Context, query string, filter domain.ArticleFilter, page, limit int) (res []domain.ArticleSearchResult, err error)
 	GetByID(ctx context.Context, id uuid.UUID) (domain.Article, error)
 	GetBySlug(ctx context.Context, title string) (domain.Article, error)
 	Update(ctx context.Context, ar *domain.Article) error
 	Store(ctx context.Context, a *domain.Article) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID, version int) error
 	SlugExistsExcludingID(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error)
 }
 
@@ -32,6 +35,8 @@ type ArticleRepository interface {
 //go:generate mockery --name AuthorRepository
 type AuthorRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (domain.Author, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Author, error)
+	GetBySlug(ctx context.Context, slug string) (domain.Author, error)
 }
 
 // CategoryRepository represent the category's repository contract
@@ -39,69 +44,47 @@ type AuthorRepository interface {
 //go:generate mockery --name CategoryRepository
 type CategoryRepository interface {
 	GetByArticleID(ctx context.Context, articleID uuid.UUID) ([]domain.Category, error)
+	GetByArticleIDs(ctx context.Context, articleIDs []uuid.UUID) (map[uuid.UUID][]domain.Category, error)
+	GetAncestors(ctx context.Context, categoryIDs []uuid.UUID) (map[uuid.UUID][]domain.Category, error)
 }
 
 type Service struct {
-	articleRepo  ArticleRepository
-	authorRepo   AuthorRepository
-	categoryRepo CategoryRepository
+	articleRepo       ArticleRepository
+	authorRepo        AuthorRepository
+	categoryRepo      CategoryRepository
+	defaultCategoryID uuid.UUID
 }
 
-// NewService will create a new article service object
-func NewService(a ArticleRepository, ar AuthorRepository, cr CategoryRepository) *Service {
+// NewService will create a new article service object,
+// articles stored without any category are put in the default category
+func NewService(a ArticleRepository, ar AuthorRepository, cr CategoryRepository, defaultCategoryID uuid.UUID) *Service {
 	return &Service{
-		articleRepo:  a,
-		authorRepo:   ar,
-		categoryRepo: cr,
+		articleRepo:       a,
+		authorRepo:        ar,
+		categoryRepo:      cr,
+		defaultCategoryID: defaultCategoryID,
 	}
 }
 
-/*
-* In this function below, I'm using errgroup with the pipeline pattern
-* Look how this works in this package explanation
-* in godoc: https://godoc.org/golang.org/x/sync/errgroup#ex-Group--Pipeline
- */
+// fillAuthorDetails loads the authors of all the articles with a single query
 func (a *Service) fillAuthorDetails(ctx context.Context, data []domain.Article) ([]domain.Article, error) {
-	g, ctx := errgroup.WithContext(ctx)
-	// Get the author's id
-	mapAuthors := map[uuid.UUID]domain.Author{}
-
-	for _, article := range data { //nolint
-		mapAuthors[article.Author.ID] = domain.Author{}
-	}
-	// Using goroutine to fetch the author's detail
-	chanAuthor := make(chan domain.Author)
-	for authorID := range mapAuthors {
-		authorID := authorID
-		g.Go(func() error {
-			res, err := a.authorRepo.GetByID(ctx, authorID)
-			if err != nil {
-				return err
-			}
-			chanAuthor <- res
-			return nil
-		})
-	}
-
-	go func() {
-		defer close(chanAuthor)
-		err := g.Wait()
-		if err != nil {
-			logrus.Error(err)
-			return
-		}
-
-	}()
-
-	for author := range chanAuthor {
-		if author.ID != uuid.Nil {
-			mapAuthors[author.ID] = author
+	var authorIDs []uuid.UUID
+	seen := map[uuid.UUID]bool{}
+	for _, article := range data {
+		if !seen[article.Author.ID] {
+			seen[article.Author.ID] = true
+			authorIDs = append(authorIDs, article.Author.ID)
 		}
 	}
 
-	if err := g.Wait(); err != nil {
+	authors, err := a.authorRepo.GetByIDs(ctx, authorIDs)
+	if err != nil {
 		return nil, err
 	}
+	mapAuthors := make(map[uuid.UUID]domain.Author, len(authors))
+	for _, author := range authors {
+		mapAuthors[author.ID] = author
+	}
 
 	// merge the author's data
 	for index, item := range data { //nolint
@@ -112,23 +95,90 @@ func (a *Service) fillAuthorDetails(ctx context.Context, data []domain.Article)
 	return data, nil
 }
 
-func (a *Service) Fetch(ctx context.Context, page, limit int) (res []domain.ArticleResponse, err error) {
-	articles, err := a.articleRepo.Fetch(ctx, page, limit)
-	if err != nil {
-		return nil, err
+// Fetch lists a page of articles along with the total number of articles matching the filter,
+// the page and the count are queried concurrently
+func (a *Service) Fetch(ctx context.Context, filter domain.ArticleFilter, page, limit int) (res []domain.ArticleResponse, total int, err error) {
+	filter = a.visibleFilter(ctx, filter)
+
+	var articles []domain.Article
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		articles, err = a.articleRepo.Fetch(gctx, filter, page, limit)
+		return err
+	})
+	g.Go(func() (err error) {
+		total, err = a.articleRepo.Count(gctx, filter)
+		return err
+	})
+	if err = g.Wait(); err != nil {
+		return nil, 0, err
 	}
 
 	articles, err = a.fillAuthorDetails(ctx, articles)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// Fill categories and generate breadcrumbs
 	res, err = a.fillCategoriesAndBreadcrumb(ctx, articles)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+	return res, total, nil
+}
+
+// FetchByCursor lists articles like Fetch but with keyset pagination, cursor is empty for the first page
+func (a *Service) FetchByCursor(ctx context.Context, filter domain.ArticleFilter, cursor string, limit int) (res []domain.ArticleResponse, page domain.PageInfo, err error) {
+	articles, page, err := a.articleRepo.FetchByCursor(ctx, a.visibleFilter(ctx, filter), cursor, limit)
+	if err != nil {
+		return nil, domain.PageInfo{}, err
+	}
+
+	articles, err = a.fillAuthorDetails(ctx, articles)
+	if err != nil {
+		return nil, domain.PageInfo{}, err
+	}
+
+	res, err = a.fillCategoriesAndBreadcrumb(ctx, articles)
+	if err != nil {
+		return nil, domain.PageInfo{}, err
+	}
+	return res, page, nil
+}
+
+// FetchByAuthorSlug lists the articles of the author identified by slug and counts them all, for author archive pages
+func (a *Service) FetchByAuthorSlug(ctx context.Context, slug string, page, limit int) (res []domain.ArticleResponse, total int, err error) {
+	author, err := a.authorRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	filter := a.visibleFilter(ctx, domain.ArticleFilter{AuthorID: &author.ID})
+
+	var articles []domain.Article
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		articles, err = a.articleRepo.Fetch(gctx, filter, page, limit)
+		return err
+	})
+	g.Go(func() (err error) {
+		total, err = a.articleRepo.Count(gctx, filter)
+		return err
+	})
+	if err = g.Wait(); err != nil {
+		return nil, 0, err
+	}
+
+	// Every article on this page shares the same author, no need to look it up again
+	for i := range articles {
+		articles[i].Author = author
 	}
-	return res, nil
+
+	res, err = a.fillCategoriesAndBreadcrumb(ctx, articles)
+	if err != nil {
+		return nil, 0, err
+	}
+	return res, total, nil
 }
 
 func (a *Service) GetByID(ctx context.Context, id uuid.UUID) (res domain.ArticleResponse, err error) {
@@ -136,6 +186,9 @@ func (a *Service) GetByID(ctx context.Context, id uuid.UUID) (res domain.Article
 	if err != nil {
 		return
 	}
+	if !a.canRead(ctx, article) {
+		return domain.ArticleResponse{}, domain.ErrNotFound
+	}
 
 	resAuthor, err := a.authorRepo.GetByID(ctx, article.Author.ID)
 	if err != nil {
@@ -151,7 +204,10 @@ func (a *Service) GetByID(ctx context.Context, id uuid.UUID) (res domain.Article
 	article.Categories = categories
 
 	// Generate breadcrumb
-	breadcrumb := a.generateBreadcrumb(&article)
+	breadcrumb, err := a.breadcrumbOf(ctx, &article)
+	if err != nil {
+		return domain.ArticleResponse{}, err
+	}
 
 	// Create response
 	res = domain.ArticleResponse{
@@ -162,24 +218,51 @@ func (a *Service) GetByID(ctx context.Context, id uuid.UUID) (res domain.Article
 	return
 }
 
+// Update replaces the article, as long as it is still at ar.Version
 func (a *Service) Update(ctx context.Context, ar *domain.Article) (err error) {
+	existingArticle, err := a.articleRepo.GetByID(ctx, ar.ID)
+	if err != nil {
+		return err
+	}
+	if existingArticle.Version != ar.Version {
+		return domain.ErrPreconditionFailed
+	}
+
+	if err = authorizeWrite(ctx, &existingArticle, *ar); err != nil {
+		return err
+	}
+
 	// Validate that the author exists
 	_, err = a.authorRepo.GetByID(ctx, ar.Author.ID)
 	if err != nil {
 		return err
 	}
 
+	// The primary category is kept unless another one is asked for
+	requested := ar.PrimaryCategoryID != nil
+	if !requested {
+		ar.PrimaryCategoryID = existingArticle.PrimaryCategoryID
+	}
+	if err = a.resolvePrimaryCategory(ctx, ar, requested); err != nil {
+		return err
+	}
+
+	applySchedule(ar, time.Now())
+	ensurePublishedAt(ar)
 	ar.UpdatedAt = time.Now()
 	return a.articleRepo.Update(ctx, ar)
 }
 
-// UpdatePartial updates only the provided fields of an article
-func (a *Service) UpdatePartial(ctx context.Context, id uuid.UUID, updates map[string]interface{}) (err error) {
+// UpdatePartial updates only the provided fields of an article, as long as it is still at the given version
+func (a *Service) UpdatePartial(ctx context.Context, id uuid.UUID, version int, updates map[string]interface{}) (err error) {
 	// Get existing article
 	existingArticle, err := a.articleRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
+	if version != domain.AnyVersion && existingArticle.Version != version {
+		return domain.ErrPreconditionFailed
+	}
 
 	// Apply partial updates
 	updatedArticle := existingArticle
@@ -207,17 +290,9 @@ func (a *Service) UpdatePartial(ctx context.Context, id uuid.UUID, updates map[s
 	if readingTime, ok := updates["reading_time_minutes"].(int); ok {
 		updatedArticle.ReadingTimeMinutes = readingTime
 	}
-	if views, ok := updates["views"].(int); ok {
-		updatedArticle.Views = views
-	}
-	if likes, ok := updates["likes"].(int); ok {
-		updatedArticle.Likes = likes
-	}
-	if comments, ok := updates["comments"].(int); ok {
-		updatedArticle.Comments = comments
-	}
 	if published, ok := updates["published"].(bool); ok {
 		updatedArticle.Published = published
+		updatedArticle.Scheduled = false
 	}
 	if publishedAt, ok := updates["published_at"].(*time.Time); ok {
 		updatedArticle.PublishedAt = publishedAt
@@ -252,6 +327,20 @@ func (a *Service) UpdatePartial(ctx context.Context, id uuid.UUID, updates map[s
 		}
 	}
 
+	primaryCategoryID, requested := updates["primary_category_id"].(uuid.UUID)
+	if requested {
+		updatedArticle.PrimaryCategoryID = &primaryCategoryID
+	}
+	if err = a.resolvePrimaryCategory(ctx, &updatedArticle, requested); err != nil {
+		return err
+	}
+
+	if err = authorizeWrite(ctx, &existingArticle, updatedArticle); err != nil {
+		return err
+	}
+
+	applySchedule(&updatedArticle, time.Now())
+	ensurePublishedAt(&updatedArticle)
 	updatedArticle.UpdatedAt = time.Now()
 	return a.articleRepo.Update(ctx, &updatedArticle)
 }
@@ -261,6 +350,9 @@ func (a *Service) GetBySlug(ctx context.Context, slug string) (res domain.Articl
 	if err != nil {
 		return
 	}
+	if !a.canRead(ctx, article) {
+		return domain.ArticleResponse{}, domain.ErrNotFound
+	}
 
 	resAuthor, err := a.authorRepo.GetByID(ctx, article.Author.ID)
 	if err != nil {
@@ -277,7 +369,10 @@ func (a *Service) GetBySlug(ctx context.Context, slug string) (res domain.Articl
 	article.Categories = categories
 
 	// Generate breadcrumb
-	breadcrumb := a.generateBreadcrumb(&article)
+	breadcrumb, err := a.breadcrumbOf(ctx, &article)
+	if err != nil {
+		return domain.ArticleResponse{}, err
+	}
 
 	// Create response
 	res = domain.ArticleResponse{
@@ -289,7 +384,16 @@ func (a *Service) GetBySlug(ctx context.Context, slug string) (res domain.Articl
 }
 
 func (a *Service) Store(ctx context.Context, m *domain.Article) (err error) {
-	existedArticle, _ := a.GetBySlug(ctx, m.Slug) // ignore if any error
+	// Authors write under their own profile unless they say otherwise
+	if user, ok := domain.UserFromContext(ctx); ok && m.Author.ID == uuid.Nil && user.AuthorID != nil {
+		m.Author.ID = *user.AuthorID
+	}
+
+	if err = authorizeWrite(ctx, nil, *m); err != nil {
+		return err
+	}
+
+	existedArticle, _ := a.articleRepo.GetBySlug(ctx, m.Slug) // ignore if any error
 	if existedArticle.ID != uuid.Nil {
 		return domain.ErrConflict
 	}
@@ -301,10 +405,20 @@ func (a *Service) Store(ctx context.Context, m *domain.Article) (err error) {
 	}
 
 	if m.Slug == "" {
-		m.Slug = generateSlug(m.Title)
+		m.Slug = slug.Generate(m.Title)
 	}
 
 	m.Slug = a.ensureUniqueSlug(ctx, m.Slug, uuid.Nil)
+	applySchedule(m, time.Now())
+	ensurePublishedAt(m)
+	m.AutoPublishedAt = nil
+
+	if len(m.Categories) == 0 {
+		m.Categories = []domain.Category{{ID: a.defaultCategoryID}}
+	}
+	if err = a.resolvePrimaryCategory(ctx, m, m.PrimaryCategoryID != nil); err != nil {
+		return err
+	}
 
 	// Generate UUID if not set
 	if m.ID == uuid.Nil {
@@ -315,7 +429,8 @@ func (a *Service) Store(ctx context.Context, m *domain.Article) (err error) {
 	return
 }
 
-func (a *Service) Delete(ctx context.Context, id uuid.UUID) (err error) {
+// Delete moves the article to the trash, as long as it is still at the given version
+func (a *Service) Delete(ctx context.Context, id uuid.UUID, version int) (err error) {
 	existedArticle, err := a.articleRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
@@ -323,55 +438,185 @@ func (a *Service) Delete(ctx context.Context, id uuid.UUID) (err error) {
 	if existedArticle.ID == uuid.Nil {
 		return domain.ErrNotFound
 	}
-	return a.articleRepo.Delete(ctx, id)
+	if version != domain.AnyVersion && existedArticle.Version != version {
+		return domain.ErrPreconditionFailed
+	}
+
+	user, err := domain.RequireRole(ctx, domain.RoleAuthor, domain.RoleEditor)
+	if err != nil {
+		return err
+	}
+	if !user.HasRole(domain.RoleEditor) && !user.IsAuthorOf(existedArticle.Author.ID) {
+		return domain.ErrForbidden
+	}
+
+	return a.articleRepo.Delete(ctx, id, existedArticle.Version)
 }
 
-func generateSlug(title string) string {
-	slug := strings.ToLower(title)
-	slug = regexp.MustCompile(`[^a-z0-9\s-]`).ReplaceAllString(slug, "")
-	slug = strings.ReplaceAll(slug, " ", "-")
-	slug = regexp.MustCompile(`-+`).ReplaceAllString(slug, "-")
-	return strings.Trim(slug, "-")
+// authorizeWrite checks that the user in ctx may turn existing into updated, existing is nil for new articles.
+// Editors and admins may write any article. Authors may only write their own articles,
+// can't hand them over to another author and can't change whether they are published.
+func authorizeWrite(ctx context.Context, existing *domain.Article, updated domain.Article) error {
+	user, err := domain.RequireRole(ctx, domain.RoleAuthor, domain.RoleEditor)
+	if err != nil {
+		return err
+	}
+	if user.HasRole(domain.RoleEditor) {
+		return nil
+	}
+
+	if !user.IsAuthorOf(updated.Author.ID) {
+		return domain.ErrForbidden
+	}
+
+	if existing == nil {
+		if updated.Published || updated.Scheduled {
+			return domain.ErrForbidden
+		}
+		return nil
+	}
+
+	if !user.IsAuthorOf(existing.Author.ID) || !samePublishState(*existing, updated) {
+		return domain.ErrForbidden
+	}
+	return nil
 }
 
-func (a *Service) ensureUniqueSlug(ctx context.Context, baseSlug string, excludeID uuid.UUID) string {
-	slug := baseSlug
-	counter := 1
+// ensurePublishedAt stamps articles published without a date as published now
+func ensurePublishedAt(ar *domain.Article) {
+	if ar.Published && ar.PublishedAt == nil {
+		now := time.Now()
+		ar.PublishedAt = &now
+	}
+}
+
+// applySchedule holds back articles asked to be published at a future date: they stay unpublished
+// and scheduled until the publisher worker flips them once published_at is reached.
+// A scheduled article sent back as is keeps its schedule.
+func applySchedule(ar *domain.Article, now time.Time) {
+	wantsPublished := ar.Published || ar.Scheduled
+	ar.Scheduled = false
+	if wantsPublished && ar.PublishedAt != nil && ar.PublishedAt.After(now) {
+		ar.Published = false
+		ar.Scheduled = true
+		return
+	}
+	ar.Published = wantsPublished
+}
 
-	for {
-		exists, err := a.articleRepo.SlugExistsExcludingID(ctx, slug, excludeID)
-		if err != nil || !exists {
-			break
+// visibleFilter restricts filter to the articles the caller in ctx may read.
+// Everyone reads published articles once their publish date has passed. In preview mode
+// editors and admins also read every unpublished article, authors only their own.
+func (a *Service) visibleFilter(ctx context.Context, filter domain.ArticleFilter) domain.ArticleFilter {
+	if user, ok := domain.UserFromContext(ctx); ok && domain.PreviewFromContext(ctx) {
+		if user.HasRole(domain.RoleEditor) {
+			return filter
 		}
+		filter.DraftsOf = user.AuthorID
+	}
+
+	now := time.Now()
+	filter.VisibleAt = &now
+	return filter
+}
+
+// canRead reports whether the caller in ctx may read the article, following the rules of visibleFilter
+func (a *Service) canRead(ctx context.Context, article domain.Article) bool {
+	if article.IsVisibleAt(time.Now()) {
+		return true
+	}
 
-		// Generate new slug with counter
-		slug = fmt.Sprintf("%s-%d", baseSlug, counter)
-		counter++
+	user, ok := domain.UserFromContext(ctx)
+	if !ok || !domain.PreviewFromContext(ctx) {
+		return false
+	}
+	return user.HasRole(domain.RoleEditor) || user.IsAuthorOf(article.Author.ID)
+}
+
+func samePublishState(a, b domain.Article) bool {
+	if a.Published != b.Published || a.Scheduled != b.Scheduled {
+		return false
+	}
+	if a.PublishedAt == nil || b.PublishedAt == nil {
+		return a.PublishedAt == b.PublishedAt
 	}
+	return a.PublishedAt.Equal(*b.PublishedAt)
+}
 
-	return slug
+func (a *Service) ensureUniqueSlug(ctx context.Context, baseSlug string, excludeID uuid.UUID) string {
+	return slug.Unique(ctx, baseSlug, excludeID, a.articleRepo.SlugExistsExcludingID)
 }
 
-// generateBreadcrumb creates breadcrumb navigation from article categories
-func (a *Service) generateBreadcrumb(article *domain.Article) []domain.BreadcrumbItem {
-	if len(article.Categories) == 0 {
-		// If no categories, create a simple breadcrumb with just the article
-		return []domain.BreadcrumbItem{
-			{Name: "Home", Link: "/"},
-			{Name: article.Title, Link: fmt.Sprintf("/articles/%s", article.Slug)},
+// resolvePrimaryCategory keeps the primary category of the article among its categories, which are the
+// linked ones when the article doesn't bring any. A primary category that was asked for has to be one of them,
+// otherwise the first category takes its place.
+func (a *Service) resolvePrimaryCategory(ctx context.Context, ar *domain.Article, requested bool) error {
+	categories := ar.Categories
+	if len(categories) == 0 {
+		var err error
+		if categories, err = a.categoryRepo.GetByArticleID(ctx, ar.ID); err != nil {
+			return err
+		}
+	}
+	if len(categories) == 0 {
+		ar.PrimaryCategoryID = nil
+		return nil
+	}
+
+	if ar.PrimaryCategoryID != nil {
+		for _, category := range categories {
+			if category.ID == *ar.PrimaryCategoryID {
+				return nil
+			}
 		}
+		if requested {
+			return domain.ErrBadParamInput
+		}
+	}
+
+	primaryCategoryID := categories[0].ID
+	ar.PrimaryCategoryID = &primaryCategoryID
+	return nil
+}
+
+// primaryCategoryID returns the category the breadcrumb of the article walks up from,
+// the first of its categories for articles stored before they had a primary category
+func primaryCategoryID(article domain.Article) (uuid.UUID, bool) {
+	if article.PrimaryCategoryID != nil {
+		return *article.PrimaryCategoryID, true
 	}
+	if len(article.Categories) > 0 {
+		return article.Categories[0].ID, true
+	}
+	return uuid.Nil, false
+}
 
-	// Create breadcrumb from categories
+// breadcrumbOf loads the ancestors of the primary category of a single article and generates its breadcrumb
+func (a *Service) breadcrumbOf(ctx context.Context, article *domain.Article) ([]domain.BreadcrumbItem, error) {
+	id, ok := primaryCategoryID(*article)
+	if !ok {
+		return a.generateBreadcrumb(article, nil), nil
+	}
+
+	trails, err := a.categoryRepo.GetAncestors(ctx, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+	return a.generateBreadcrumb(article, trails[id]), nil
+}
+
+// generateBreadcrumb creates breadcrumb navigation walking down the trail of categories,
+// from the root to the primary category of the article
+func (a *Service) generateBreadcrumb(article *domain.Article, trail []domain.Category) []domain.BreadcrumbItem {
 	breadcrumb := []domain.BreadcrumbItem{
 		{Name: "Home", Link: "/"},
 	}
 
-	// Add category breadcrumbs
-	for _, category := range article.Categories {
+	// Add category breadcrumbs, linked by their nested path
+	for _, category := range trail {
 		breadcrumb = append(breadcrumb, domain.BreadcrumbItem{
 			Name: category.Name,
-			Link: fmt.Sprintf("/categories/%s", category.Slug),
+			Link: fmt.Sprintf("/categories/%s", category.Path),
 		})
 	}
 
@@ -384,22 +629,44 @@ func (a *Service) generateBreadcrumb(article *domain.Article) []domain.Breadcrum
 	return breadcrumb
 }
 
-// fillCategoriesAndBreadcrumb loads categories and generates breadcrumbs for articles
+// fillCategoriesAndBreadcrumb loads categories and the ancestors of the primary categories with a query each,
+// and generates breadcrumbs for articles
 func (a *Service) fillCategoriesAndBreadcrumb(ctx context.Context, articles []domain.Article) ([]domain.ArticleResponse, error) {
 	responses := make([]domain.ArticleResponse, len(articles))
 
+	articleIDs := make([]uuid.UUID, len(articles))
 	for i, article := range articles {
-		// Fetch categories for this article
-		categories, err := a.categoryRepo.GetByArticleID(ctx, article.ID)
-		if err != nil {
-			return nil, err
+		articleIDs[i] = article.ID
+	}
+
+	// Fetch the categories of every article at once
+	categories, err := a.categoryRepo.GetByArticleIDs(ctx, articleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Then the ancestors of every primary category at once
+	var primaryIDs []uuid.UUID
+	seen := map[uuid.UUID]bool{}
+	for _, article := range articles {
+		article.Categories = categories[article.ID]
+		if id, ok := primaryCategoryID(article); ok && !seen[id] {
+			seen[id] = true
+			primaryIDs = append(primaryIDs, id)
 		}
+	}
+	trails, err := a.categoryRepo.GetAncestors(ctx, primaryIDs)
+	if err != nil {
+		return nil, err
+	}
 
+	for i, article := range articles {
 		// Set categories on the article
-		article.Categories = categories
+		article.Categories = categories[article.ID]
 
-		// Generate breadcrumb
-		breadcrumb := a.generateBreadcrumb(&article)
+		// Generate breadcrumb, articles without any category only have an empty trail
+		id, _ := primaryCategoryID(article)
+		breadcrumb := a.generateBreadcrumb(&article, trails[id])
 
 		// Create response with breadcrumb
 		responses[i] = domain.ArticleResponse{
@@ -0,0 +1,127 @@
+package article
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// batchRepos counts the lookups made while enriching a page of articles
+type batchRepos struct {
+	authors    map[uuid.UUID]domain.Author
+	categories map[uuid.UUID][]domain.Category
+	ancestors  map[uuid.UUID][]domain.Category
+	calls      int
+}
+
+func (r *batchRepos) GetByID(context.Context, uuid.UUID) (domain.Author, error) {
+	r.calls++
+	return domain.Author{}, nil
+}
+
+func (r *batchRepos) GetByIDs(_ context.Context, ids []uuid.UUID) (res []domain.Author, err error) {
+	r.calls++
+	for _, id := range ids {
+		res = append(res, r.authors[id])
+	}
+	return res, nil
+}
+
+func (r *batchRepos) GetBySlug(context.Context, string) (domain.Author, error) {
+	r.calls++
+	return domain.Author{}, nil
+}
+
+func (r *batchRepos) GetByArticleID(context.Context, uuid.UUID) ([]domain.Category, error) {
+	r.calls++
+	return nil, nil
+}
+
+func (r *batchRepos) GetByArticleIDs(context.Context, []uuid.UUID) (map[uuid.UUID][]domain.Category, error) {
+	r.calls++
+	return r.categories, nil
+}
+
+func (r *batchRepos) GetAncestors(context.Context, []uuid.UUID) (map[uuid.UUID][]domain.Category, error) {
+	r.calls++
+	return r.ancestors, nil
+}
+
+func TestEnrichmentUsesBatchLookups(t *testing.T) {
+	ctx := context.Background()
+	alice := domain.Author{ID: uuid.New(), Name: "Alice"}
+	bob := domain.Author{ID: uuid.New(), Name: "Bob"}
+	articles := []domain.Article{
+		{ID: uuid.New(), Title: "One", Slug: "one", Author: domain.Author{ID: alice.ID}},
+		{ID: uuid.New(), Title: "Two", Slug: "two", Author: domain.Author{ID: bob.ID}},
+		{ID: uuid.New(), Title: "Three", Slug: "three", Author: domain.Author{ID: alice.ID}},
+	}
+	uae := domain.Category{ID: uuid.New(), Name: "UAE", Slug: "uae", Level: 1, Path: "uae"}
+	dubai := domain.Category{ID: uuid.New(), Name: "Dubai", Slug: "dubai", ParentID: &uae.ID}
+	chain := []domain.Category{uae, dubai}
+	chain[1].Level, chain[1].Path = 2, "uae/dubai"
+	repos := &batchRepos{
+		authors:    map[uuid.UUID]domain.Author{alice.ID: alice, bob.ID: bob},
+		categories: map[uuid.UUID][]domain.Category{articles[1].ID: {dubai}},
+		ancestors:  map[uuid.UUID][]domain.Category{dubai.ID: chain},
+	}
+	svc := NewService(nil, repos, repos, uuid.Nil)
+
+	filled, err := svc.fillAuthorDetails(ctx, articles)
+	require.NoError(t, err)
+	res, err := svc.fillCategoriesAndBreadcrumb(ctx, filled)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, repos.calls)
+	require.Len(t, res, 3)
+	assert.Equal(t, "Alice", res[0].Author.Name)
+	assert.Equal(t, "Bob", res[1].Author.Name)
+	assert.Equal(t, "Alice", res[2].Author.Name)
+	assert.Empty(t, res[0].Categories)
+	assert.Equal(t, []domain.Category{dubai}, res[1].Categories)
+	assert.Equal(t, []domain.BreadcrumbItem{
+		{Name: "Home", Link: "/"},
+		{Name: "UAE", Link: "/categories/uae"},
+		{Name: "Dubai", Link: "/categories/uae/dubai"},
+		{Name: "Two", Link: "/articles/two"},
+	}, res[1].Breadcrumb)
+	assert.Len(t, res[0].Breadcrumb, 2)
+}
+
+// versionedRepo holds a single article at a given version, any other call panics
+type versionedRepo struct {
+	ArticleRepository
+	article        domain.Article
+	deletedVersion int
+}
+
+func (r *versionedRepo) GetByID(context.Context, uuid.UUID) (domain.Article, error) {
+	return r.article, nil
+}
+
+func (r *versionedRepo) Delete(_ context.Context, _ uuid.UUID, version int) error {
+	r.deletedVersion = version
+	return nil
+}
+
+func TestWritesCheckTheVersion(t *testing.T) {
+	ctx := domain.NewContextWithUser(context.Background(), domain.User{Role: domain.RoleEditor})
+	repo := &versionedRepo{article: domain.Article{ID: uuid.New(), Version: 3}}
+	svc := NewService(repo, nil, nil, uuid.Nil)
+
+	err := svc.UpdatePartial(ctx, repo.article.ID, 2, map[string]interface{}{"title": "Stale"})
+	assert.Equal(t, domain.ErrPreconditionFailed, err)
+
+	err = svc.Delete(ctx, repo.article.ID, 2)
+	assert.Equal(t, domain.ErrPreconditionFailed, err)
+	assert.Zero(t, repo.deletedVersion)
+
+	err = svc.Delete(ctx, repo.article.ID, domain.AnyVersion)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, repo.deletedVersion)
+}
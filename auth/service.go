@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// UserRepository represent the user's repository contract
+//
+//go:generate mockery --name UserRepository
+type UserRepository interface {
+	GetByEmail(ctx context.Context, email string) (domain.User, error)
+}
+
+// claims is the payload carried by the signed access tokens
+type claims struct {
+	Email    string      `json:"email"`
+	Role     domain.Role `json:"role"`
+	AuthorID *uuid.UUID  `json:"author_id,omitempty"`
+	jwt.RegisteredClaims
+}
+
+type Service struct {
+	userRepo UserRepository
+	secret   []byte
+	ttl      time.Duration
+}
+
+// NewService will create a new auth service object signing tokens with the given HMAC secret
+func NewService(ur UserRepository, secret []byte, ttl time.Duration) *Service {
+	return &Service{
+		userRepo: ur,
+		secret:   secret,
+		ttl:      ttl,
+	}
+}
+
+// Login checks the given credentials and issues a signed access token valid until expiresAt
+func (s *Service) Login(ctx context.Context, email, password string) (token string, expiresAt time.Time, err error) {
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if err == domain.ErrNotFound {
+			return "", time.Time{}, domain.ErrUnauthorized
+		}
+		return "", time.Time{}, err
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password))
+	if err != nil {
+		return "", time.Time{}, domain.ErrUnauthorized
+	}
+
+	now := time.Now()
+	expiresAt = now.Add(s.ttl)
+	c := claims{
+		Email:    user.Email,
+		Role:     user.Role,
+		AuthorID: user.AuthorID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   user.ID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	token, err = jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString(s.secret)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return token, expiresAt, nil
+}
+
+// Authenticate verifies a signed access token and returns the user it was issued for
+func (s *Service) Authenticate(_ context.Context, token string) (domain.User, error) {
+	c := claims{}
+	_, err := jwt.ParseWithClaims(token, &c, func(t *jwt.Token) (interface{}, error) {
+		return s.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return domain.User{}, domain.ErrUnauthorized
+	}
+
+	userID, err := uuid.Parse(c.Subject)
+	if err != nil {
+		return domain.User{}, domain.ErrUnauthorized
+	}
+
+	return domain.User{
+		ID:       userID,
+		Email:    c.Email,
+		Role:     c.Role,
+		AuthorID: c.AuthorID,
+	}, nil
+}
@@ -0,0 +1,117 @@
+package author
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+	"github.com/bxcodec/go-clean-arch/internal/slug"
+)
+
+// AuthorRepository represent the author's repository contract
+//
+//go:generate mockery --name AuthorRepository
+type AuthorRepository interface {
+	Fetch(ctx context.Context, page, limit int) ([]domain.Author, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Author, error)
+	GetBySlug(ctx context.Context, slug string) (domain.Author, error)
+	Store(ctx context.Context, a *domain.Author) error
+	Update(ctx context.Context, a *domain.Author) error
+	Delete(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) error
+	SlugExistsExcludingID(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error)
+}
+
+type Service struct {
+	authorRepo AuthorRepository
+}
+
+// NewService will create a new author service object
+func NewService(ar AuthorRepository) *Service {
+	return &Service{
+		authorRepo: ar,
+	}
+}
+
+func (s *Service) Fetch(ctx context.Context, page, limit int) ([]domain.Author, error) {
+	return s.authorRepo.Fetch(ctx, page, limit)
+}
+
+func (s *Service) GetByID(ctx context.Context, id uuid.UUID) (domain.Author, error) {
+	return s.authorRepo.GetByID(ctx, id)
+}
+
+func (s *Service) GetBySlug(ctx context.Context, slug string) (domain.Author, error) {
+	return s.authorRepo.GetBySlug(ctx, slug)
+}
+
+func (s *Service) Store(ctx context.Context, a *domain.Author) error {
+	// Only admins create author profiles
+	if _, err := domain.RequireRole(ctx, domain.RoleAdmin); err != nil {
+		return err
+	}
+
+	if a.Slug == "" {
+		a.Slug = slug.Generate(a.Name)
+	}
+
+	a.Slug = s.ensureUniqueSlug(ctx, a.Slug, uuid.Nil)
+
+	// Generate UUID if not set
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+
+	return s.authorRepo.Store(ctx, a)
+}
+
+func (s *Service) Update(ctx context.Context, a *domain.Author) error {
+	// Authors may keep their own profile up to date, admins manage every profile
+	user, err := domain.RequireRole(ctx, domain.RoleAuthor, domain.RoleEditor)
+	if err != nil {
+		return err
+	}
+	if !user.HasRole(domain.RoleAdmin) && !user.IsAuthorOf(a.ID) {
+		return domain.ErrForbidden
+	}
+
+	if a.Slug == "" {
+		a.Slug = slug.Generate(a.Name)
+	}
+
+	a.Slug = s.ensureUniqueSlug(ctx, a.Slug, a.ID)
+
+	a.UpdatedAt = time.Now()
+	return s.authorRepo.Update(ctx, a)
+}
+
+// Delete removes an author. Authors that still own articles are only deleted
+// when reassignTo names another existing author to hand the articles over to;
+// otherwise ErrAuthorHasArticles is returned.
+func (s *Service) Delete(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) error {
+	// Only admins remove author profiles
+	if _, err := domain.RequireRole(ctx, domain.RoleAdmin); err != nil {
+		return err
+	}
+
+	if _, err := s.authorRepo.GetByID(ctx, id); err != nil {
+		return err
+	}
+
+	if reassignTo != nil {
+		if *reassignTo == id {
+			return domain.ErrBadParamInput
+		}
+		if _, err := s.authorRepo.GetByID(ctx, *reassignTo); err != nil {
+			return err
+		}
+	}
+
+	// The repository refuses to delete an author who still has articles
+	return s.authorRepo.Delete(ctx, id, reassignTo)
+}
+
+func (s *Service) ensureUniqueSlug(ctx context.Context, baseSlug string, excludeID uuid.UUID) string {
+	return slug.Unique(ctx, baseSlug, excludeID, s.authorRepo.SlugExistsExcludingID)
+}
@@ -3,13 +3,13 @@ package category
 import (
 	"context"
 	"fmt"
-	"regexp"
-	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/bxcodec/go-clean-arch/domain"
+	"github.com/bxcodec/go-clean-arch/internal/slug"
 )
 
 // CategoryRepository represent the category's repository contract
@@ -17,38 +17,88 @@ import (
 //go:generate mockery --name CategoryRepository
 type CategoryRepository interface {
 	Fetch(ctx context.Context, page, limit int) ([]domain.Category, error)
+	Count(ctx context.Context) (int, error)
+	FetchByCursor(ctx context.Context, cursor string, limit int) ([]domain.Category, domain.PageInfo, error)
 	GetBySlug(ctx context.Context, slug string) (domain.Category, error)
 	GetByID(ctx context.Context, id uuid.UUID) (domain.Category, error)
 	Update(ctx context.Context, category *domain.Category) error
+	Move(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) error
 	Store(ctx context.Context, category *domain.Category) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID, deletion domain.CategoryDeletion) error
+	RestoreBySlug(ctx context.Context, slug string) error
 	SlugExistsExcludingID(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error)
 	GetByArticleID(ctx context.Context, articleID uuid.UUID) ([]domain.Category, error)
 	GetByIDs(ctx context.Context, categoryIDs []uuid.UUID) ([]domain.Category, error)
 	GetChildren(ctx context.Context, parentID uuid.UUID) ([]domain.Category, error)
 	GetRootCategories(ctx context.Context) ([]domain.Category, error)
-	GetCategoryTree(ctx context.Context) ([]domain.Category, error)
+	GetCategoryTree(ctx context.Context, rootSlug string, depth int) ([]domain.Category, error)
 }
 
 type Service struct {
 	categoryRepo CategoryRepository
+	defaultSlug  string
 }
 
-// NewService will create a new category service object
-func NewService(cr CategoryRepository) *Service {
+// NewService will create a new category service object,
+// the category with the default slug is the one articles without any category fall back to
+func NewService(cr CategoryRepository, defaultSlug string) *Service {
 	return &Service{
 		categoryRepo: cr,
+		defaultSlug:  defaultSlug,
 	}
 }
 
-func (c *Service) Fetch(ctx context.Context, page, limit int) (res []domain.Category, err error) {
-	res, err = c.categoryRepo.Fetch(ctx, page, limit)
-	if err != nil {
-		return nil, err
+// EnsureDefault returns the default category, creating it under the given name when it is missing.
+// A trashed category holding the default slug is restored rather than replaced.
+func (c *Service) EnsureDefault(ctx context.Context, name string) (domain.Category, error) {
+	res, err := c.categoryRepo.GetBySlug(ctx, c.defaultSlug)
+	if err != domain.ErrNotFound {
+		return res, err
+	}
+
+	err = c.categoryRepo.RestoreBySlug(ctx, c.defaultSlug)
+	if err == nil {
+		return c.categoryRepo.GetBySlug(ctx, c.defaultSlug)
+	}
+	if err != domain.ErrNotFound {
+		return domain.Category{}, err
+	}
+
+	res = domain.Category{ID: uuid.New(), Name: name, Slug: c.defaultSlug}
+	if err = c.categoryRepo.Store(ctx, &res); err != nil {
+		// Another instance may have created it in the meantime
+		if existing, getErr := c.categoryRepo.GetBySlug(ctx, c.defaultSlug); getErr == nil {
+			return existing, nil
+		}
+		return domain.Category{}, fmt.Errorf("creating the default category %q: %w", c.defaultSlug, err)
 	}
 	return res, nil
 }
 
+// Fetch lists a page of categories along with the total number of categories,
+// the page and the count are queried concurrently
+func (c *Service) Fetch(ctx context.Context, page, limit int) (res []domain.Category, total int, err error) {
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		res, err = c.categoryRepo.Fetch(gctx, page, limit)
+		return err
+	})
+	g.Go(func() (err error) {
+		total, err = c.categoryRepo.Count(gctx)
+		return err
+	})
+
+	if err = g.Wait(); err != nil {
+		return nil, 0, err
+	}
+	return res, total, nil
+}
+
+// FetchByCursor lists categories with keyset pagination, see Fetch for page based listing
+func (c *Service) FetchByCursor(ctx context.Context, cursor string, limit int) (res []domain.Category, page domain.PageInfo, err error) {
+	return c.categoryRepo.FetchByCursor(ctx, cursor, limit)
+}
+
 func (c *Service) GetBySlug(ctx context.Context, slug string) (res domain.Category, err error) {
 	res, err = c.categoryRepo.GetBySlug(ctx, slug)
 	if err != nil {
@@ -66,11 +116,44 @@ func (c *Service) GetByID(ctx context.Context, id uuid.UUID) (res domain.Categor
 }
 
 func (c *Service) Update(ctx context.Context, category *domain.Category) (err error) {
+	// Only editors and admins manage categories
+	if _, err = domain.RequireRole(ctx, domain.RoleEditor); err != nil {
+		return err
+	}
+
+	existing, err := c.categoryRepo.GetByID(ctx, category.ID)
+	if err != nil {
+		return err
+	}
+	if existing.Slug == c.defaultSlug && category.Slug != existing.Slug {
+		return domain.ErrDefaultCategory
+	}
+
+	// The repository checks a new parent the way Move does
 	category.UpdatedAt = time.Now()
 	return c.categoryRepo.Update(ctx, category)
 }
 
+// Move puts the category under parentID, or makes it a root category when parentID is nil.
+// Its whole subtree follows it, and moving it under itself or one of its descendants fails with ErrCategoryCycle.
+func (c *Service) Move(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) (res domain.Category, err error) {
+	// Only editors and admins manage categories
+	if _, err = domain.RequireRole(ctx, domain.RoleEditor); err != nil {
+		return domain.Category{}, err
+	}
+
+	if err = c.categoryRepo.Move(ctx, id, parentID); err != nil {
+		return domain.Category{}, err
+	}
+	return c.categoryRepo.GetByID(ctx, id)
+}
+
 func (c *Service) Store(ctx context.Context, category *domain.Category) (err error) {
+	// Only editors and admins manage categories
+	if _, err = domain.RequireRole(ctx, domain.RoleEditor); err != nil {
+		return err
+	}
+
 	// Check if category with same slug already exists
 	existedCategory, _ := c.GetBySlug(ctx, category.Slug) // ignore if any error
 	if existedCategory.ID != uuid.Nil {
@@ -78,7 +161,7 @@ func (c *Service) Store(ctx context.Context, category *domain.Category) (err err
 	}
 
 	if category.Slug == "" {
-		category.Slug = generateSlug(category.Name)
+		category.Slug = slug.Generate(category.Name)
 	}
 
 	category.Slug = c.ensureUniqueSlug(ctx, category.Slug, uuid.Nil)
@@ -92,36 +175,42 @@ func (c *Service) Store(ctx context.Context, category *domain.Category) (err err
 	return
 }
 
-func (c *Service) Delete(ctx context.Context, id uuid.UUID) (err error) {
-	// Check if category exists by trying to delete it
-	// The repository will return ErrNotFound if it doesn't exist
-	return c.categoryRepo.Delete(ctx, id)
-}
-
-func generateSlug(name string) string {
-	slug := strings.ToLower(name)
-	slug = regexp.MustCompile(`[^a-z0-9\s-]`).ReplaceAllString(slug, "")
-	slug = strings.ReplaceAll(slug, " ", "-")
-	slug = regexp.MustCompile(`-+`).ReplaceAllString(slug, "-")
-	return strings.Trim(slug, "-")
-}
+// Delete moves the category to the trash, what happens to its children and articles depends on the strategy.
+// Without any strategy a category that isn't empty is kept, and the default category is never deleted.
+func (c *Service) Delete(ctx context.Context, id uuid.UUID, strategy domain.CategoryDeleteStrategy, targetID *uuid.UUID) (err error) {
+	// Only editors and admins manage categories
+	if _, err = domain.RequireRole(ctx, domain.RoleEditor); err != nil {
+		return err
+	}
 
-func (c *Service) ensureUniqueSlug(ctx context.Context, baseSlug string, excludeID uuid.UUID) string {
-	slug := baseSlug
-	counter := 1
+	defaultCategory, err := c.categoryRepo.GetBySlug(ctx, c.defaultSlug)
+	if err != nil {
+		return err
+	}
+	if id == defaultCategory.ID {
+		return domain.ErrDefaultCategory
+	}
 
-	for {
-		exists, err := c.categoryRepo.SlugExistsExcludingID(ctx, slug, excludeID)
-		if err != nil || !exists {
-			break
+	deletion := domain.CategoryDeletion{Strategy: strategy, FallbackID: defaultCategory.ID}
+	switch strategy {
+	case "":
+		deletion.Strategy = domain.CategoryDeleteRestrict
+	case domain.CategoryDeleteRestrict, domain.CategoryDeleteToParent:
+	case domain.CategoryDeleteMoveTo:
+		if targetID == nil {
+			return domain.ErrBadParamInput
 		}
-
-		// Generate new slug with counter
-		slug = fmt.Sprintf("%s-%d", baseSlug, counter)
-		counter++
+		deletion.TargetID = targetID
+	default:
+		return domain.ErrBadParamInput
 	}
 
-	return slug
+	// The repository will return ErrNotFound if the category doesn't exist
+	return c.categoryRepo.Delete(ctx, id, deletion)
+}
+
+func (c *Service) ensureUniqueSlug(ctx context.Context, baseSlug string, excludeID uuid.UUID) string {
+	return slug.Unique(ctx, baseSlug, excludeID, c.categoryRepo.SlugExistsExcludingID)
 }
 
 // GetChildren retrieves all children of a category
@@ -134,9 +223,12 @@ func (c *Service) GetRootCategories(ctx context.Context) ([]domain.Category, err
 	return c.categoryRepo.GetRootCategories(ctx)
 }
 
-// GetCategoryTree retrieves the complete category tree
-func (c *Service) GetCategoryTree(ctx context.Context) ([]domain.Category, error) {
-	return c.categoryRepo.GetCategoryTree(ctx)
+// GetCategoryTree retrieves the category tree, or the subtree under rootSlug when given, down to depth levels when positive
+func (c *Service) GetCategoryTree(ctx context.Context, rootSlug string, depth int) ([]domain.Category, error) {
+	if depth < 0 {
+		return nil, domain.ErrBadParamInput
+	}
+	return c.categoryRepo.GetCategoryTree(ctx, rootSlug, depth)
 }
 
 // GetCategoryWithChildren retrieves a category with its children
@@ -0,0 +1,133 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// memoryRepo keeps categories by slug, any call it doesn't implement panics
+type memoryRepo struct {
+	CategoryRepository
+	bySlug    map[string]domain.Category
+	trashed   map[string]domain.Category
+	deletions []domain.CategoryDeletion
+	storeErr  error
+}
+
+func (r *memoryRepo) GetBySlug(_ context.Context, slug string) (domain.Category, error) {
+	category, ok := r.bySlug[slug]
+	if !ok {
+		return domain.Category{}, domain.ErrNotFound
+	}
+	return category, nil
+}
+
+func (r *memoryRepo) Delete(_ context.Context, _ uuid.UUID, deletion domain.CategoryDeletion) error {
+	r.deletions = append(r.deletions, deletion)
+	return nil
+}
+
+func (r *memoryRepo) Store(_ context.Context, category *domain.Category) error {
+	if r.storeErr != nil {
+		return r.storeErr
+	}
+	r.bySlug[category.Slug] = *category
+	return nil
+}
+
+func (r *memoryRepo) RestoreBySlug(_ context.Context, slug string) error {
+	category, ok := r.trashed[slug]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	delete(r.trashed, slug)
+	r.bySlug[slug] = category
+	return nil
+}
+
+func editorContext() context.Context {
+	return domain.NewContextWithUser(context.Background(), domain.User{Role: domain.RoleEditor})
+}
+
+func TestDeleteProtectsTheDefaultCategory(t *testing.T) {
+	uncategorized := domain.Category{ID: uuid.New(), Slug: "uncategorized"}
+	repo := &memoryRepo{bySlug: map[string]domain.Category{"uncategorized": uncategorized}}
+	svc := NewService(repo, "uncategorized")
+
+	err := svc.Delete(editorContext(), uncategorized.ID, domain.CategoryDeleteToParent, nil)
+
+	assert.Equal(t, domain.ErrDefaultCategory, err)
+	assert.Empty(t, repo.deletions)
+}
+
+func TestDeleteStrategies(t *testing.T) {
+	uncategorized := domain.Category{ID: uuid.New(), Slug: "uncategorized"}
+	target := uuid.New()
+	repo := &memoryRepo{bySlug: map[string]domain.Category{"uncategorized": uncategorized}}
+	svc := NewService(repo, "uncategorized")
+	ctx := editorContext()
+
+	require.NoError(t, svc.Delete(ctx, uuid.New(), "", nil))
+	require.NoError(t, svc.Delete(ctx, uuid.New(), domain.CategoryDeleteToParent, nil))
+	require.NoError(t, svc.Delete(ctx, uuid.New(), domain.CategoryDeleteMoveTo, &target))
+	assert.Equal(t, domain.ErrBadParamInput, svc.Delete(ctx, uuid.New(), domain.CategoryDeleteMoveTo, nil))
+	assert.Equal(t, domain.ErrBadParamInput, svc.Delete(ctx, uuid.New(), "cascade", nil))
+
+	require.Len(t, repo.deletions, 3)
+	assert.Equal(t, domain.CategoryDeleteRestrict, repo.deletions[0].Strategy)
+	assert.Equal(t, uncategorized.ID, repo.deletions[1].FallbackID)
+	assert.Equal(t, &target, repo.deletions[2].TargetID)
+}
+
+func TestEnsureDefault(t *testing.T) {
+	ctx := context.Background()
+	existing := domain.Category{ID: uuid.New(), Name: "Misc", Slug: "uncategorized"}
+
+	t.Run("existing", func(t *testing.T) {
+		repo := &memoryRepo{bySlug: map[string]domain.Category{"uncategorized": existing}}
+
+		res, err := NewService(repo, "uncategorized").EnsureDefault(ctx, "Uncategorized")
+
+		require.NoError(t, err)
+		assert.Equal(t, existing, res)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		repo := &memoryRepo{bySlug: map[string]domain.Category{}}
+
+		res, err := NewService(repo, "uncategorized").EnsureDefault(ctx, "Uncategorized")
+
+		require.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, res.ID)
+		assert.Equal(t, "Uncategorized", res.Name)
+		assert.Equal(t, res, repo.bySlug["uncategorized"])
+	})
+
+	t.Run("trashed", func(t *testing.T) {
+		repo := &memoryRepo{
+			bySlug:   map[string]domain.Category{},
+			trashed:  map[string]domain.Category{"uncategorized": existing},
+			storeErr: errors.New("duplicate entry"),
+		}
+
+		res, err := NewService(repo, "uncategorized").EnsureDefault(ctx, "Uncategorized")
+
+		require.NoError(t, err)
+		assert.Equal(t, existing, res)
+	})
+
+	t.Run("store fails", func(t *testing.T) {
+		repo := &memoryRepo{bySlug: map[string]domain.Category{}, storeErr: errors.New("duplicate entry")}
+
+		_, err := NewService(repo, "uncategorized").EnsureDefault(ctx, "Uncategorized")
+
+		assert.ErrorContains(t, err, `creating the default category "uncategorized"`)
+	})
+}
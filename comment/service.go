@@ -0,0 +1,186 @@
+package comment
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// CommentRepository represent the comment's repository contract
+//
+//go:generate mockery --name CommentRepository
+type CommentRepository interface {
+	FetchByArticle(ctx context.Context, articleID uuid.UUID, status domain.CommentStatus) ([]domain.Comment, error)
+	FetchByStatus(ctx context.Context, status domain.CommentStatus, page, limit int) ([]domain.Comment, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Comment, error)
+	Store(ctx context.Context, c *domain.Comment) error
+	UpdateStatus(ctx context.Context, c *domain.Comment) error
+	Delete(ctx context.Context, c *domain.Comment) error
+}
+
+// ArticleRepository represent the article's repository contract
+//
+//go:generate mockery --name ArticleRepository
+type ArticleRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Article, error)
+}
+
+type Service struct {
+	commentRepo CommentRepository
+	articleRepo ArticleRepository
+}
+
+// NewService will create a new comment service object
+func NewService(cr CommentRepository, ar ArticleRepository) *Service {
+	return &Service{
+		commentRepo: cr,
+		articleRepo: ar,
+	}
+}
+
+// FetchByArticle returns the comment threads of an article. Readers only get approved comments,
+// editors get every comment so they can moderate in place.
+func (s *Service) FetchByArticle(ctx context.Context, articleID uuid.UUID) ([]domain.Comment, error) {
+	if _, err := s.readableArticle(ctx, articleID); err != nil {
+		return nil, err
+	}
+
+	status := domain.CommentApproved
+	if isModerator(ctx) {
+		status = ""
+	}
+
+	comments, err := s.commentRepo.FetchByArticle(ctx, articleID, status)
+	if err != nil {
+		return nil, err
+	}
+	return buildThreads(comments), nil
+}
+
+// FetchByStatus returns the comments of every article in the given moderation state, for editors
+func (s *Service) FetchByStatus(ctx context.Context, status domain.CommentStatus, page, limit int) ([]domain.Comment, error) {
+	if _, err := domain.RequireRole(ctx, domain.RoleEditor); err != nil {
+		return nil, err
+	}
+	if !status.Valid() {
+		return nil, domain.ErrBadParamInput
+	}
+
+	return s.commentRepo.FetchByStatus(ctx, status, page, limit)
+}
+
+// Store adds a comment, or a reply when ParentID is set, to a readable article.
+// Comments wait in the moderation queue unless an editor writes them.
+func (s *Service) Store(ctx context.Context, c *domain.Comment) error {
+	if _, err := s.readableArticle(ctx, c.ArticleID); err != nil {
+		return err
+	}
+
+	if c.ParentID != nil {
+		parent, err := s.commentRepo.GetByID(ctx, *c.ParentID)
+		if err != nil {
+			if err == domain.ErrNotFound {
+				return domain.ErrBadParamInput
+			}
+			return err
+		}
+		// Replies stay on the parent's article and only answer comments readers can see
+		if parent.ArticleID != c.ArticleID || (parent.Status != domain.CommentApproved && !isModerator(ctx)) {
+			return domain.ErrBadParamInput
+		}
+	}
+
+	c.Status = domain.CommentPending
+	if isModerator(ctx) {
+		c.Status = domain.CommentApproved
+	}
+
+	c.ID = uuid.New()
+	c.Replies = nil
+	c.CreatedAt = time.Now()
+	c.UpdatedAt = c.CreatedAt
+	return s.commentRepo.Store(ctx, c)
+}
+
+// Moderate changes the moderation state of a comment, for editors
+func (s *Service) Moderate(ctx context.Context, id uuid.UUID, status domain.CommentStatus) (domain.Comment, error) {
+	if _, err := domain.RequireRole(ctx, domain.RoleEditor); err != nil {
+		return domain.Comment{}, err
+	}
+	if !status.Valid() {
+		return domain.Comment{}, domain.ErrBadParamInput
+	}
+
+	c, err := s.commentRepo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+
+	c.Status = status
+	c.UpdatedAt = time.Now()
+	if err = s.commentRepo.UpdateStatus(ctx, &c); err != nil {
+		return domain.Comment{}, err
+	}
+	return c, nil
+}
+
+// Delete removes a comment and its replies, for editors
+func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := domain.RequireRole(ctx, domain.RoleEditor); err != nil {
+		return err
+	}
+
+	c, err := s.commentRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return s.commentRepo.Delete(ctx, &c)
+}
+
+// readableArticle returns the article when it is live, or when an editor is asking
+func (s *Service) readableArticle(ctx context.Context, id uuid.UUID) (domain.Article, error) {
+	article, err := s.articleRepo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	if !article.IsVisibleAt(time.Now()) && !isModerator(ctx) {
+		return domain.Article{}, domain.ErrNotFound
+	}
+	return article, nil
+}
+
+func isModerator(ctx context.Context) bool {
+	user, ok := domain.UserFromContext(ctx)
+	return ok && user.HasRole(domain.RoleEditor)
+}
+
+// buildThreads nests the replies under the comment they answer, keeping the order of comments.
+// Replies to comments missing from the list, e.g. not approved yet, are left out.
+func buildThreads(comments []domain.Comment) []domain.Comment {
+	children := make(map[uuid.UUID][]domain.Comment)
+	var roots []domain.Comment
+	for _, c := range comments {
+		if c.ParentID == nil {
+			roots = append(roots, c)
+			continue
+		}
+		children[*c.ParentID] = append(children[*c.ParentID], c)
+	}
+
+	var attach func(c domain.Comment) domain.Comment
+	attach = func(c domain.Comment) domain.Comment {
+		for _, reply := range children[c.ID] {
+			c.Replies = append(c.Replies, attach(reply))
+		}
+		return c
+	}
+
+	threads := make([]domain.Comment, 0, len(roots))
+	for _, root := range roots {
+		threads = append(threads, attach(root))
+	}
+	return threads
+}
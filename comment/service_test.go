@@ -0,0 +1,50 @@
+package comment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+func TestBuildThreads(t *testing.T) {
+	first := domain.Comment{ID: uuid.New(), Content: "first"}
+	second := domain.Comment{ID: uuid.New(), Content: "second"}
+	reply := domain.Comment{ID: uuid.New(), ParentID: &first.ID, Content: "reply"}
+	nested := domain.Comment{ID: uuid.New(), ParentID: &reply.ID, Content: "nested"}
+	missingParent := uuid.New()
+	orphan := domain.Comment{ID: uuid.New(), ParentID: &missingParent, Content: "orphan"}
+
+	threads := buildThreads([]domain.Comment{first, reply, orphan, second, nested})
+
+	require.Len(t, threads, 2)
+	assert.Equal(t, "first", threads[0].Content)
+	assert.Equal(t, "second", threads[1].Content)
+	require.Len(t, threads[0].Replies, 1)
+	assert.Equal(t, "reply", threads[0].Replies[0].Content)
+	require.Len(t, threads[0].Replies[0].Replies, 1)
+	assert.Equal(t, "nested", threads[0].Replies[0].Replies[0].Content)
+	assert.Empty(t, threads[1].Replies)
+}
+
+// missingArticles answers every lookup like the article repository does for a missing article
+type missingArticles struct{}
+
+func (missingArticles) GetByID(context.Context, uuid.UUID) (domain.Article, error) {
+	return domain.Article{}, domain.ErrNotFound
+}
+
+func TestCommentsOfMissingArticle(t *testing.T) {
+	// The comment repository is never reached, any call panics
+	svc := NewService(struct{ CommentRepository }{}, missingArticles{})
+
+	_, err := svc.FetchByArticle(context.Background(), uuid.New())
+	assert.Equal(t, domain.ErrNotFound, err)
+
+	err = svc.Store(context.Background(), &domain.Comment{ArticleID: uuid.New(), AuthorName: "Ann", Content: "Hello"})
+	assert.Equal(t, domain.ErrNotFound, err)
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"context"
 	"database/sql/driver"
 	"encoding/json"
 	"time"
@@ -21,6 +22,7 @@ type Article struct {
 	Keywords           JSONStringSlice `json:"keywords"`
 	Tags               JSONStringSlice `json:"tags"`
 	Categories         []Category      `json:"categories"`
+	PrimaryCategoryID  *uuid.UUID      `json:"primary_category_id,omitempty"`
 	Author             Author          `json:"author"`
 	ReadingTimeMinutes int             `json:"reading_time_minutes"`
 	Views              int             `json:"views"`
@@ -28,8 +30,20 @@ type Article struct {
 	Comments           int             `json:"comments"`
 	Published          bool            `json:"published"`
 	PublishedAt        *time.Time      `json:"published_at,omitempty"`
+	Scheduled          bool            `json:"scheduled"`
+	AutoPublishedAt    *time.Time      `json:"auto_published_at,omitempty"`
 	UpdatedAt          time.Time       `json:"updated_at"`
 	CreatedAt          time.Time       `json:"created_at"`
+	Version            int             `json:"version"`
+	DeletedAt          *time.Time      `json:"deleted_at,omitempty"`
+}
+
+// AnyVersion stands for whatever version an article is at, for writes that don't check it
+const AnyVersion = 0
+
+// IsVisibleAt reports whether the public can read the article at the given time
+func (a Article) IsVisibleAt(t time.Time) bool {
+	return a.Published && a.PublishedAt != nil && !a.PublishedAt.After(t)
 }
 
 type ArticleCategory struct {
@@ -39,6 +53,22 @@ type ArticleCategory struct {
 	CreatedAt  time.Time `json:"created_at"`
 }
 
+// ArticleFilter is representing the criteria used to narrow down article listings.
+// Zero values don't filter anything.
+type ArticleFilter struct {
+	CategorySlug       string
+	IncludeDescendants bool
+	Tag                string
+	AuthorID           *uuid.UUID
+	Published          *bool
+	PublishedFrom      *time.Time
+	PublishedTo        *time.Time
+	// VisibleAt restricts the listing to articles the public can read at that time,
+	// plus the unpublished articles of DraftsOf when it is set
+	VisibleAt *time.Time
+	DraftsOf  *uuid.UUID
+}
+
 type BreadcrumbItem struct {
 	Name string `json:"name"`
 	Link string `json:"link"`
@@ -49,6 +79,27 @@ type ArticleResponse struct {
 	Breadcrumb []BreadcrumbItem `json:"breadcrumb"`
 }
 
+// ArticleSearchResult is representing an article matched by a full-text search
+type ArticleSearchResult struct {
+	ArticleResponse
+	Score   float64 `json:"score"`
+	Snippet string  `json:"snippet"`
+}
+
+type previewContextKey struct{}
+
+// NewContextWithPreview returns a copy of ctx asking to include unpublished articles
+// the authenticated user is allowed to see
+func NewContextWithPreview(ctx context.Context) context.Context {
+	return context.WithValue(ctx, previewContextKey{}, true)
+}
+
+// PreviewFromContext reports whether ctx asks for unpublished articles to be included
+func PreviewFromContext(ctx context.Context) bool {
+	preview, _ := ctx.Value(previewContextKey{}).(bool)
+	return preview
+}
+
 // JSONStringSlice is a custom type that handles JSON marshaling/unmarshaling for string slices
 type JSONStringSlice []string
 
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,8 +10,44 @@ import (
 
 // Author representing the Author data struct
 type Author struct {
-	ID        uuid.UUID `json:"id"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID          uuid.UUID     `json:"id"`
+	Name        string        `json:"name" validate:"required"`
+	Slug        string        `json:"slug"`
+	Bio         string        `json:"bio,omitempty"`
+	Avatar      string        `json:"avatar,omitempty" validate:"omitempty,url"`
+	SocialLinks JSONStringMap `json:"social_links,omitempty" validate:"omitempty,dive,url"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+}
+
+// JSONStringMap is a custom type that handles JSON marshaling/unmarshaling for string maps,
+// e.g. an author's social links keyed by network name
+type JSONStringMap map[string]string
+
+// Scan implements the sql.Scanner interface for database/sql
+func (j *JSONStringMap) Scan(value interface{}) error {
+	if value == nil {
+		*j = nil
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return nil
+	}
+
+	return json.Unmarshal(bytes, j)
+}
+
+// Value implements the driver.Valuer interface for database/sql
+func (j JSONStringMap) Value() (driver.Value, error) {
+	if j == nil {
+		return nil, nil
+	}
+	return json.Marshal(j)
 }
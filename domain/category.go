@@ -19,4 +19,27 @@ type Category struct {
 	Path        string     `json:"path,omitempty"`
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+// CategoryDeleteStrategy says what happens to the children and the articles of a deleted category
+type CategoryDeleteStrategy string
+
+const (
+	// CategoryDeleteRestrict refuses to delete a category that still has children or articles
+	CategoryDeleteRestrict CategoryDeleteStrategy = "restrict"
+	// CategoryDeleteToParent hands the children and the articles over to the parent of the category.
+	// The children of a root category become root categories and its articles go to the fallback category.
+	CategoryDeleteToParent CategoryDeleteStrategy = "parent"
+	// CategoryDeleteMoveTo hands the children and the articles over to a given category
+	CategoryDeleteMoveTo CategoryDeleteStrategy = "move"
+)
+
+// CategoryDeletion is representing how a category is deleted
+type CategoryDeletion struct {
+	Strategy CategoryDeleteStrategy
+	// TargetID receives the children and the articles with CategoryDeleteMoveTo
+	TargetID *uuid.UUID
+	// FallbackID receives the articles of a root category deleted with CategoryDeleteToParent
+	FallbackID uuid.UUID
 }
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CommentStatus is the moderation state of a comment
+type CommentStatus string
+
+const (
+	CommentPending  CommentStatus = "pending"
+	CommentApproved CommentStatus = "approved"
+	CommentSpam     CommentStatus = "spam"
+)
+
+// Valid reports whether s is one of the known moderation states
+func (s CommentStatus) Valid() bool {
+	switch s {
+	case CommentPending, CommentApproved, CommentSpam:
+		return true
+	}
+	return false
+}
+
+// Comment is a reader comment on an article, replies point at the comment they answer through ParentID
+type Comment struct {
+	ID         uuid.UUID     `json:"id"`
+	ArticleID  uuid.UUID     `json:"article_id"`
+	ParentID   *uuid.UUID    `json:"parent_id,omitempty"`
+	AuthorName string        `json:"author_name" validate:"required,max=100"`
+	Content    string        `json:"content" validate:"required,max=5000"`
+	Status     CommentStatus `json:"status"`
+	Replies    []Comment     `json:"replies,omitempty"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
+}
@@ -11,4 +11,20 @@ var (
 	ErrConflict = errors.New("your Item already exist")
 	// ErrBadParamInput will throw if the given request-body or params is not valid
 	ErrBadParamInput = errors.New("given Param is not valid")
+	// ErrUnauthorized will throw if the request credentials are missing or not valid
+	ErrUnauthorized = errors.New("invalid or missing credentials")
+	// ErrForbidden will throw if the authenticated user is not allowed to perform the action
+	ErrForbidden = errors.New("you are not allowed to perform this action")
+	// ErrAuthorHasArticles will throw if the author is still referenced by articles
+	ErrAuthorHasArticles = errors.New("author still has articles")
+	// ErrPreconditionRequired will throw if a write doesn't say which version of the item it applies to
+	ErrPreconditionRequired = errors.New("the If-Match header is required")
+	// ErrPreconditionFailed will throw if the item changed since the version the write applies to
+	ErrPreconditionFailed = errors.New("the item has been modified since it was read")
+	// ErrCategoryCycle will throw if a category would end up under itself
+	ErrCategoryCycle = errors.New("a category can't be moved under itself or one of its descendants")
+	// ErrCategoryNotEmpty will throw if a category still having children or articles is deleted without reassigning them
+	ErrCategoryNotEmpty = errors.New("category still has children or articles")
+	// ErrDefaultCategory will throw if the default category is deleted or its slug changed
+	ErrDefaultCategory = errors.New("the default category can't be deleted or have its slug changed")
 )
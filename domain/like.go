@@ -0,0 +1,10 @@
+package domain
+
+import "github.com/google/uuid"
+
+// LikeStatus tells whether a client likes an article and how many likes the article has
+type LikeStatus struct {
+	ArticleID uuid.UUID `json:"article_id"`
+	Liked     bool      `json:"liked"`
+	Likes     int       `json:"likes"`
+}
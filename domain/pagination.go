@@ -0,0 +1,8 @@
+package domain
+
+// PageInfo holds the cursors around a page of a keyset paginated listing.
+// A cursor is empty when there is nothing more in that direction.
+type PageInfo struct {
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+}
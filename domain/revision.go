@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ArticleRevision is an immutable snapshot of an article taken every time it is saved.
+// Revisions are numbered per article starting from 1.
+type ArticleRevision struct {
+	ID                 uuid.UUID       `json:"id"`
+	ArticleID          uuid.UUID       `json:"article_id"`
+	Revision           int             `json:"revision"`
+	EditorID           *uuid.UUID      `json:"editor_id,omitempty"`
+	Title              string          `json:"title"`
+	Slug               string          `json:"slug"`
+	Content            string          `json:"content"`
+	Thumbnail          string          `json:"thumbnail"`
+	Image              string          `json:"image"`
+	ShortDescription   string          `json:"short_description"`
+	MetaDescription    string          `json:"meta_description"`
+	Keywords           JSONStringSlice `json:"keywords"`
+	Tags               JSONStringSlice `json:"tags"`
+	ReadingTimeMinutes int             `json:"reading_time_minutes"`
+	Published          bool            `json:"published"`
+	PublishedAt        *time.Time      `json:"published_at,omitempty"`
+	AuthorID           uuid.UUID       `json:"author_id"`
+	CreatedAt          time.Time       `json:"created_at"`
+}
+
+// FieldChange is a field whose value differs between two revisions
+type FieldChange struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+// RevisionDiff lists the fields changed from one revision to another
+type RevisionDiff struct {
+	From    int           `json:"from"`
+	To      int           `json:"to"`
+	Changes []FieldChange `json:"changes"`
+}
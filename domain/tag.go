@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// Tag is a free-form label shared by articles, identified in URLs by its slug
+type Tag struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name" validate:"required,max=100"`
+	Slug      string    `json:"slug"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TagCount is a tag with the number of published articles using it, for tag clouds
+type TagCount struct {
+	Tag
+	Articles int `json:"articles"`
+}
+
+// TagSlug derives the slug of a tag name. Names differing only in case, spacing or punctuation
+// share the same slug and so are the same tag.
+func TagSlug(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			dash = false
+			b.WriteRune(r)
+		case unicode.IsSpace(r) || r == '-' || r == '_':
+			dash = true
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,23 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+func TestTagSlug(t *testing.T) {
+	tests := map[string]string{
+		"Cooking":           "cooking",
+		"  Street   Food  ": "street-food",
+		"road_trip--tips":   "road-trip-tips",
+		"Hội An!":           "hội-an",
+		"C++ & Go":          "c-go",
+		"!!!":               "",
+	}
+	for name, want := range tests {
+		assert.Equal(t, want, domain.TagSlug(name), name)
+	}
+}
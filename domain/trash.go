@@ -0,0 +1,7 @@
+package domain
+
+// Trash holds the soft deleted articles and categories waiting to be restored or purged
+type Trash struct {
+	Articles   []Article  `json:"articles"`
+	Categories []Category `json:"categories"`
+}
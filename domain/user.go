@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Role is representing what a user is allowed to do
+type Role string
+
+const (
+	// RoleAdmin can do everything
+	RoleAdmin Role = "admin"
+	// RoleEditor can publish any article and manage categories
+	RoleEditor Role = "editor"
+	// RoleAuthor can only write, edit and delete their own articles
+	RoleAuthor Role = "author"
+)
+
+// User is representing an account that can sign in and write content
+type User struct {
+	ID           uuid.UUID  `json:"id"`
+	Email        string     `json:"email"`
+	PasswordHash string     `json:"-"`
+	Role         Role       `json:"role"`
+	AuthorID     *uuid.UUID `json:"author_id,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// HasRole reports whether the user holds one of the given roles. Admins hold every role.
+func (u User) HasRole(roles ...Role) bool {
+	if u.Role == RoleAdmin {
+		return true
+	}
+	for _, role := range roles {
+		if u.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAuthorOf reports whether the user is linked to the given author profile
+func (u User) IsAuthorOf(authorID uuid.UUID) bool {
+	return u.AuthorID != nil && *u.AuthorID == authorID
+}
+
+type userContextKey struct{}
+
+// NewContextWithUser returns a copy of ctx carrying the authenticated user
+func NewContextWithUser(ctx context.Context, u User) context.Context {
+	return context.WithValue(ctx, userContextKey{}, u)
+}
+
+// UserFromContext returns the authenticated user stored in ctx, if any
+func UserFromContext(ctx context.Context) (User, bool) {
+	u, ok := ctx.Value(userContextKey{}).(User)
+	return u, ok
+}
+
+// RequireRole returns the authenticated user stored in ctx when it holds one of the given roles.
+// It fails with ErrUnauthorized when ctx carries no user and ErrForbidden when the role does not match.
+func RequireRole(ctx context.Context, roles ...Role) (User, error) {
+	u, ok := UserFromContext(ctx)
+	if !ok {
+		return User{}, ErrUnauthorized
+	}
+	if !u.HasRole(roles...) {
+		return User{}, ErrForbidden
+	}
+	return u, nil
+}
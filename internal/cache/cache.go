@@ -0,0 +1,14 @@
+// Package cache provides the key/value stores the read-through repositories keep their results in
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Cache stores serialized values by key for a limited time.
+// A ttl of zero keeps the value until it is evicted.
+type Cache interface {
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
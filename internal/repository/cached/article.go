@@ -0,0 +1,90 @@
+package cached
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+	"github.com/bxcodec/go-clean-arch/internal/cache"
+	"github.com/bxcodec/go-clean-arch/internal/repository/mysql"
+)
+
+// ArticleRepository caches single article lookups. Writes invalidate every cached article,
+// and the cached categories of articles since they change the article links.
+// Counters like views or likes may lag behind by up to the ttl.
+type ArticleRepository struct {
+	*mysql.ArticleRepository
+	articles   namespace
+	categories namespace
+}
+
+// NewArticleRepository will wrap repo with a cache keeping entries for ttl
+func NewArticleRepository(repo *mysql.ArticleRepository, c cache.Cache, ttl time.Duration) *ArticleRepository {
+	return &ArticleRepository{
+		ArticleRepository: repo,
+		articles:          namespace{cache: c, name: articleNamespace, ttl: ttl},
+		categories:        namespace{cache: c, name: categoryNamespace, ttl: ttl},
+	}
+}
+
+const articleNamespace = "article"
+
+func (m *ArticleRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Article, error) {
+	return readThrough(ctx, m.articles, func() (domain.Article, error) {
+		return m.ArticleRepository.GetByID(ctx, id)
+	}, "id", id.String())
+}
+
+func (m *ArticleRepository) GetBySlug(ctx context.Context, slug string) (domain.Article, error) {
+	return readThrough(ctx, m.articles, func() (domain.Article, error) {
+		return m.ArticleRepository.GetBySlug(ctx, slug)
+	}, "slug", slug)
+}
+
+func (m *ArticleRepository) Store(ctx context.Context, a *domain.Article) error {
+	if err := m.ArticleRepository.Store(ctx, a); err != nil {
+		return err
+	}
+	m.invalidate(ctx)
+	return nil
+}
+
+func (m *ArticleRepository) Update(ctx context.Context, ar *domain.Article) error {
+	if err := m.ArticleRepository.Update(ctx, ar); err != nil {
+		return err
+	}
+	m.invalidate(ctx)
+	return nil
+}
+
+func (m *ArticleRepository) Delete(ctx context.Context, id uuid.UUID, version int) error {
+	if err := m.ArticleRepository.Delete(ctx, id, version); err != nil {
+		return err
+	}
+	m.invalidate(ctx)
+	return nil
+}
+
+func (m *ArticleRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	if err := m.ArticleRepository.Restore(ctx, id); err != nil {
+		return err
+	}
+	m.invalidate(ctx)
+	return nil
+}
+
+// PublishDue invalidates the cache when scheduled articles went live
+func (m *ArticleRepository) PublishDue(ctx context.Context, now time.Time, limit int) (int64, error) {
+	published, err := m.ArticleRepository.PublishDue(ctx, now, limit)
+	if published > 0 {
+		m.invalidate(ctx)
+	}
+	return published, err
+}
+
+func (m *ArticleRepository) invalidate(ctx context.Context) {
+	m.articles.invalidate(ctx)
+	m.categories.invalidate(ctx)
+}
@@ -0,0 +1,43 @@
+package cached
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+	"github.com/bxcodec/go-clean-arch/internal/cache"
+	"github.com/bxcodec/go-clean-arch/internal/repository/mysql"
+)
+
+// AuthorRepository invalidates the cached articles when an author changes or is deleted,
+// as deleting an author may hand their articles over to another one
+type AuthorRepository struct {
+	*mysql.AuthorRepository
+	articles namespace
+}
+
+// NewAuthorRepository will wrap repo so author writes invalidate the article cache
+func NewAuthorRepository(repo *mysql.AuthorRepository, c cache.Cache, ttl time.Duration) *AuthorRepository {
+	return &AuthorRepository{
+		AuthorRepository: repo,
+		articles:         namespace{cache: c, name: articleNamespace, ttl: ttl},
+	}
+}
+
+func (m *AuthorRepository) Update(ctx context.Context, a *domain.Author) error {
+	if err := m.AuthorRepository.Update(ctx, a); err != nil {
+		return err
+	}
+	m.articles.invalidate(ctx)
+	return nil
+}
+
+func (m *AuthorRepository) Delete(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) error {
+	if err := m.AuthorRepository.Delete(ctx, id, reassignTo); err != nil {
+		return err
+	}
+	m.articles.invalidate(ctx)
+	return nil
+}
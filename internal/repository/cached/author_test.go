@@ -0,0 +1,52 @@
+package cached
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+	"github.com/bxcodec/go-clean-arch/internal/cache"
+	"github.com/bxcodec/go-clean-arch/internal/repository/mysql"
+)
+
+func TestAuthorDeleteInvalidatesArticles(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	c := cache.NewLRU(10)
+	repo := NewAuthorRepository(mysql.NewAuthorRepository(db), c, time.Minute)
+	articles := namespace{cache: c, name: articleNamespace, ttl: time.Minute}
+
+	id, reassignTo := uuid.New(), uuid.New()
+	loads := 0
+	load := func() (domain.Article, error) {
+		loads++
+		return domain.Article{Author: domain.Author{ID: id}}, nil
+	}
+	_, err = readThrough(ctx, articles, load, "slug", "hello")
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id FROM author").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id.String()))
+	mock.ExpectExec("UPDATE article SET author_id").
+		WithArgs(reassignTo.String(), id.String()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("DELETE FROM author").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, repo.Delete(ctx, id, &reassignTo))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	_, err = readThrough(ctx, articles, load, "slug", "hello")
+	require.NoError(t, err)
+	assert.Equal(t, 2, loads)
+}
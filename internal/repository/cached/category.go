@@ -0,0 +1,118 @@
+package cached
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+	"github.com/bxcodec/go-clean-arch/internal/cache"
+	"github.com/bxcodec/go-clean-arch/internal/repository/mysql"
+)
+
+// CategoryRepository caches single category lookups, the categories of articles and the category tree.
+// Writes invalidate every cached category, deletions also invalidate the cached articles as they may
+// hand their primary category over to another one.
+type CategoryRepository struct {
+	*mysql.CategoryRepository
+	categories namespace
+	articles   namespace
+}
+
+// NewCategoryRepository will wrap repo with a cache keeping entries for ttl
+func NewCategoryRepository(repo *mysql.CategoryRepository, c cache.Cache, ttl time.Duration) *CategoryRepository {
+	return &CategoryRepository{
+		CategoryRepository: repo,
+		categories:         namespace{cache: c, name: categoryNamespace, ttl: ttl},
+		articles:           namespace{cache: c, name: articleNamespace, ttl: ttl},
+	}
+}
+
+const categoryNamespace = "category"
+
+func (m *CategoryRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Category, error) {
+	return readThrough(ctx, m.categories, func() (domain.Category, error) {
+		return m.CategoryRepository.GetByID(ctx, id)
+	}, "id", id.String())
+}
+
+func (m *CategoryRepository) GetBySlug(ctx context.Context, slug string) (domain.Category, error) {
+	return readThrough(ctx, m.categories, func() (domain.Category, error) {
+		return m.CategoryRepository.GetBySlug(ctx, slug)
+	}, "slug", slug)
+}
+
+func (m *CategoryRepository) GetByArticleID(ctx context.Context, articleID uuid.UUID) ([]domain.Category, error) {
+	return readThrough(ctx, m.categories, func() ([]domain.Category, error) {
+		return m.CategoryRepository.GetByArticleID(ctx, articleID)
+	}, "article", articleID.String())
+}
+
+func (m *CategoryRepository) GetChildren(ctx context.Context, parentID uuid.UUID) ([]domain.Category, error) {
+	return readThrough(ctx, m.categories, func() ([]domain.Category, error) {
+		return m.CategoryRepository.GetChildren(ctx, parentID)
+	}, "children", parentID.String())
+}
+
+func (m *CategoryRepository) GetRootCategories(ctx context.Context) ([]domain.Category, error) {
+	return readThrough(ctx, m.categories, func() ([]domain.Category, error) {
+		return m.CategoryRepository.GetRootCategories(ctx)
+	}, "roots")
+}
+
+func (m *CategoryRepository) GetCategoryTree(ctx context.Context, rootSlug string, depth int) ([]domain.Category, error) {
+	return readThrough(ctx, m.categories, func() ([]domain.Category, error) {
+		return m.CategoryRepository.GetCategoryTree(ctx, rootSlug, depth)
+	}, "tree", rootSlug, strconv.Itoa(depth))
+}
+
+func (m *CategoryRepository) Store(ctx context.Context, category *domain.Category) error {
+	if err := m.CategoryRepository.Store(ctx, category); err != nil {
+		return err
+	}
+	m.categories.invalidate(ctx)
+	return nil
+}
+
+func (m *CategoryRepository) Update(ctx context.Context, category *domain.Category) error {
+	if err := m.CategoryRepository.Update(ctx, category); err != nil {
+		return err
+	}
+	m.categories.invalidate(ctx)
+	return nil
+}
+
+func (m *CategoryRepository) Move(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) error {
+	if err := m.CategoryRepository.Move(ctx, id, parentID); err != nil {
+		return err
+	}
+	m.categories.invalidate(ctx)
+	return nil
+}
+
+func (m *CategoryRepository) Delete(ctx context.Context, id uuid.UUID, deletion domain.CategoryDeletion) error {
+	if err := m.CategoryRepository.Delete(ctx, id, deletion); err != nil {
+		return err
+	}
+	m.categories.invalidate(ctx)
+	m.articles.invalidate(ctx)
+	return nil
+}
+
+func (m *CategoryRepository) RestoreBySlug(ctx context.Context, slug string) error {
+	if err := m.CategoryRepository.RestoreBySlug(ctx, slug); err != nil {
+		return err
+	}
+	m.categories.invalidate(ctx)
+	return nil
+}
+
+func (m *CategoryRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	if err := m.CategoryRepository.Restore(ctx, id); err != nil {
+		return err
+	}
+	m.categories.invalidate(ctx)
+	return nil
+}
@@ -0,0 +1,89 @@
+// Package cached decorates the MySQL repositories with a read-through cache
+package cached
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/bxcodec/go-clean-arch/internal/cache"
+)
+
+// namespace groups cache entries that are invalidated together. Every key embeds the namespace's
+// current generation, so invalidating only takes writing a new generation and the old entries age out.
+type namespace struct {
+	cache cache.Cache
+	name  string
+	ttl   time.Duration
+}
+
+func (n namespace) generationKey() string {
+	return n.name + ":generation"
+}
+
+// generation returns the current generation, starting a new one when the cache lost it.
+// Generations are taken from the clock so a lost one is never reused.
+func (n namespace) generation(ctx context.Context) (string, error) {
+	gen, ok, err := n.cache.Get(ctx, n.generationKey())
+	if err != nil {
+		return "", err
+	}
+	if ok {
+		return string(gen), nil
+	}
+	return n.newGeneration(ctx)
+}
+
+func (n namespace) newGeneration(ctx context.Context) (string, error) {
+	gen := strconv.FormatInt(time.Now().UnixNano(), 36)
+	if err := n.cache.Set(ctx, n.generationKey(), []byte(gen), 0); err != nil {
+		return "", err
+	}
+	return gen, nil
+}
+
+// invalidate drops every entry of the namespace. Failures are logged, entries then live out their ttl.
+func (n namespace) invalidate(ctx context.Context) {
+	if _, err := n.newGeneration(ctx); err != nil {
+		logrus.Error("failed to invalidate the ", n.name, " cache: ", err)
+	}
+}
+
+// readThrough returns the cached value under key, or loads it and caches it.
+// The cache never fails a read, errors are logged and the value is loaded.
+func readThrough[T any](ctx context.Context, n namespace, load func() (T, error), key ...string) (T, error) {
+	gen, err := n.generation(ctx)
+	if err != nil {
+		logrus.Error("failed to read the ", n.name, " cache: ", err)
+		return load()
+	}
+	fullKey := n.name + ":" + gen + ":" + strings.Join(key, ":")
+
+	if data, ok, err := n.cache.Get(ctx, fullKey); err != nil {
+		logrus.Error("failed to read the ", n.name, " cache: ", err)
+	} else if ok {
+		var value T
+		if err = json.Unmarshal(data, &value); err == nil {
+			return value, nil
+		}
+		logrus.Error("failed to decode the ", n.name, " cache: ", err)
+	}
+
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+
+	data, err := json.Marshal(value)
+	if err == nil {
+		err = n.cache.Set(ctx, fullKey, data, n.ttl)
+	}
+	if err != nil {
+		logrus.Error("failed to fill the ", n.name, " cache: ", err)
+	}
+	return value, nil
+}
@@ -0,0 +1,57 @@
+package cached
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+	"github.com/bxcodec/go-clean-arch/internal/cache"
+)
+
+func TestReadThrough(t *testing.T) {
+	ctx := context.Background()
+	ns := namespace{cache: cache.NewLRU(10), name: "article", ttl: time.Minute}
+
+	loads := 0
+	load := func() (domain.Article, error) {
+		loads++
+		return domain.Article{Title: "v" + strconv.Itoa(loads)}, nil
+	}
+
+	first, err := readThrough(ctx, ns, load, "slug", "hello")
+	require.NoError(t, err)
+	cachedHit, err := readThrough(ctx, ns, load, "slug", "hello")
+	require.NoError(t, err)
+	assert.Equal(t, "v1", first.Title)
+	assert.Equal(t, "v1", cachedHit.Title)
+	assert.Equal(t, 1, loads)
+
+	ns.invalidate(ctx)
+	reloaded, err := readThrough(ctx, ns, load, "slug", "hello")
+	require.NoError(t, err)
+	assert.Equal(t, "v2", reloaded.Title)
+	assert.Equal(t, 2, loads)
+}
+
+func TestReadThroughDoesNotCacheErrors(t *testing.T) {
+	ctx := context.Background()
+	ns := namespace{cache: cache.NewLRU(10), name: "article", ttl: time.Minute}
+
+	loads := 0
+	load := func() (domain.Article, error) {
+		loads++
+		return domain.Article{}, errors.New("boom")
+	}
+
+	_, err := readThrough(ctx, ns, load, "id", "1")
+	assert.Error(t, err)
+	_, err = readThrough(ctx, ns, load, "id", "1")
+	assert.Error(t, err)
+	assert.Equal(t, 2, loads)
+}
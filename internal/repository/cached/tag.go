@@ -0,0 +1,32 @@
+package cached
+
+import (
+	"context"
+	"time"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+	"github.com/bxcodec/go-clean-arch/internal/cache"
+	"github.com/bxcodec/go-clean-arch/internal/repository/mysql"
+)
+
+// TagRepository invalidates the cached articles when a tag is renamed, as they carry the tag names
+type TagRepository struct {
+	*mysql.TagRepository
+	articles namespace
+}
+
+// NewTagRepository will wrap repo so tag writes invalidate the article cache
+func NewTagRepository(repo *mysql.TagRepository, c cache.Cache, ttl time.Duration) *TagRepository {
+	return &TagRepository{
+		TagRepository: repo,
+		articles:      namespace{cache: c, name: articleNamespace, ttl: ttl},
+	}
+}
+
+func (m *TagRepository) Update(ctx context.Context, t *domain.Tag) error {
+	if err := m.TagRepository.Update(ctx, t); err != nil {
+		return err
+	}
+	m.articles.invalidate(ctx)
+	return nil
+}
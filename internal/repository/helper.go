@@ -2,29 +2,61 @@ package repository
 
 import (
 	"encoding/base64"
+	"errors"
+	"strings"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 const (
-	timeFormat = "2006-01-02T15:04:05.999Z07:00" // reduce precision from RFC3339Nano as date format
+	timeFormat = "2006-01-02T15:04:05.999999Z07:00" // keep the microseconds MySQL stores
+
+	cursorNext = "n"
+	cursorPrev = "p"
 )
 
+// ErrInvalidCursor is returned when a cursor given by the user can't be decoded
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor points at a row of a listing ordered by (created_at, id) descending.
+// Backward cursors ask for the rows before the pointed one, forward cursors for the rows after it.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+	Backward  bool
+}
+
 // DecodeCursor will decode cursor from user for mysql
-func DecodeCursor(encodedTime string) (time.Time, error) {
-	byt, err := base64.StdEncoding.DecodeString(encodedTime)
+func DecodeCursor(encoded string) (Cursor, error) {
+	byt, err := base64.RawURLEncoding.DecodeString(encoded)
 	if err != nil {
-		return time.Time{}, err
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	parts := strings.SplitN(string(byt), "|", 3)
+	if len(parts) != 3 || (parts[0] != cursorNext && parts[0] != cursorPrev) {
+		return Cursor{}, ErrInvalidCursor
 	}
 
-	timeString := string(byt)
-	t, err := time.Parse(timeFormat, timeString)
+	t, err := time.Parse(timeFormat, parts[1])
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	id, err := uuid.Parse(parts[2])
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
 
-	return t, err
+	return Cursor{CreatedAt: t, ID: id, Backward: parts[0] == cursorPrev}, nil
 }
 
 // EncodeCursor will encode cursor from mysql to user
-func EncodeCursor(t time.Time) string {
-	timeString := t.Format(timeFormat)
+func EncodeCursor(c Cursor) string {
+	direction := cursorNext
+	if c.Backward {
+		direction = cursorPrev
+	}
 
-	return base64.StdEncoding.EncodeToString([]byte(timeString))
+	return base64.RawURLEncoding.EncodeToString([]byte(direction + "|" + c.CreatedAt.Format(timeFormat) + "|" + c.ID.String()))
 }
@@ -0,0 +1,29 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bxcodec/go-clean-arch/internal/repository"
+)
+
+func TestCursor(t *testing.T) {
+	c := repository.Cursor{
+		CreatedAt: time.Date(2024, 3, 1, 10, 30, 15, 123456000, time.UTC),
+		ID:        uuid.New(),
+		Backward:  true,
+	}
+
+	decoded, err := repository.DecodeCursor(repository.EncodeCursor(c))
+	require.NoError(t, err)
+	assert.True(t, c.CreatedAt.Equal(decoded.CreatedAt))
+	assert.Equal(t, c.ID, decoded.ID)
+	assert.True(t, decoded.Backward)
+
+	_, err = repository.DecodeCursor("not a cursor")
+	assert.ErrorIs(t, err, repository.ErrInvalidCursor)
+}
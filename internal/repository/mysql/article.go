@@ -4,13 +4,18 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
 	"github.com/bxcodec/go-clean-arch/domain"
+	"github.com/bxcodec/go-clean-arch/internal/repository"
 )
 
+const articleColumns = `id, title, slug, content, thumbnail, image, short_description, meta_description, keywords, reading_time_minutes, views, likes, comments, published, published_at, scheduled, auto_published_at, author_id, updated_at, created_at, version, deleted_at, primary_category_id`
+
 type ArticleRepository struct {
 	Conn *sql.DB
 }
@@ -21,6 +26,18 @@ func NewArticleRepository(conn *sql.DB) *ArticleRepository {
 }
 
 func (m *ArticleRepository) fetch(ctx context.Context, query string, args ...interface{}) (result []domain.Article, err error) {
+	result, err = m.fetchRows(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = m.fillTags(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (m *ArticleRepository) fetchRows(ctx context.Context, query string, args ...interface{}) (result []domain.Article, err error) {
 	rows, err := m.Conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		logrus.Error(err)
@@ -36,60 +53,394 @@ func (m *ArticleRepository) fetch(ctx context.Context, query string, args ...int
 
 	result = make([]domain.Article, 0)
 	for rows.Next() {
-		t := domain.Article{}
-		var authorID uuid.UUID
-		err = rows.Scan(
-			&t.ID,
-			&t.Title,
-			&t.Slug,
-			&t.Content,
-			&t.Thumbnail,
-			&t.Image,
-			&t.ShortDescription,
-			&t.MetaDescription,
-			&t.Keywords,
-			&t.Tags,
-			&t.ReadingTimeMinutes,
-			&t.Views,
-			&t.Likes,
-			&t.Comments,
-			&t.Published,
-			&t.PublishedAt,
-			&authorID,
-			&t.UpdatedAt,
-			&t.CreatedAt,
-		)
-
+		var t domain.Article
+		t, err = scanArticle(rows)
 		if err != nil {
 			logrus.Error(err)
 			return nil, err
 		}
-		t.Author = domain.Author{
-			ID: authorID,
-		}
 		result = append(result, t)
 	}
 
 	return result, nil
 }
 
-func (m *ArticleRepository) Fetch(ctx context.Context, page, limit int) (res []domain.Article, err error) {
+// fillTags loads the tag names of all the articles with a single query
+func (m *ArticleRepository) fillTags(ctx context.Context, articles []domain.Article) error {
+	if len(articles) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(articles))
+	args := make([]interface{}, len(articles))
+	for i, a := range articles {
+		placeholders[i] = "?"
+		args[i] = a.ID
+	}
+
+	query := `SELECT at.article_id, t.name
+			  FROM article_tag at
+			  INNER JOIN tag t ON t.id = at.tag_id
+			  WHERE at.article_id IN (` + joinStrings(placeholders, ",") + `)
+			  ORDER BY t.name`
+
+	rows, err := m.Conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	defer func() {
+		errRow := rows.Close()
+		if errRow != nil {
+			logrus.Error(errRow)
+		}
+	}()
+
+	tags := make(map[uuid.UUID]domain.JSONStringSlice)
+	for rows.Next() {
+		var articleID uuid.UUID
+		var name string
+		if err = rows.Scan(&articleID, &name); err != nil {
+			logrus.Error(err)
+			return err
+		}
+		tags[articleID] = append(tags[articleID], name)
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	for i := range articles {
+		articles[i].Tags = tags[articles[i].ID]
+		if articles[i].Tags == nil {
+			articles[i].Tags = domain.JSONStringSlice{}
+		}
+	}
+	return nil
+}
+
+// syncTags makes names the tags of the article, creating the tags that don't exist yet
+func syncTags(ctx context.Context, tx *sql.Tx, articleID uuid.UUID, names []string, now time.Time) error {
+	_, err := tx.ExecContext(ctx, `DELETE FROM article_tag WHERE article_id = ?`, articleID)
+	if err != nil {
+		return err
+	}
+
+	linked := make(map[string]bool)
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		slug := domain.TagSlug(name)
+		if slug == "" || linked[slug] {
+			continue
+		}
+		linked[slug] = true
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO tag (id, name, slug, created_at, updated_at) VALUES (?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE id = id`, uuid.New(), name, slug, now, now)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO article_tag (article_id, tag_id) SELECT ?, id FROM tag WHERE slug = ?`, articleID, slug)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanArticle reads an article row selected with the article columns, followed by any extra columns
+func scanArticle(row rowScanner, extra ...interface{}) (domain.Article, error) {
+	t := domain.Article{}
+	var authorID uuid.UUID
+	dest := []interface{}{
+		&t.ID,
+		&t.Title,
+		&t.Slug,
+		&t.Content,
+		&t.Thumbnail,
+		&t.Image,
+		&t.ShortDescription,
+		&t.MetaDescription,
+		&t.Keywords,
+		&t.ReadingTimeMinutes,
+		&t.Views,
+		&t.Likes,
+		&t.Comments,
+		&t.Published,
+		&t.PublishedAt,
+		&t.Scheduled,
+		&t.AutoPublishedAt,
+		&authorID,
+		&t.UpdatedAt,
+		&t.CreatedAt,
+		&t.Version,
+		&t.DeletedAt,
+		&t.PrimaryCategoryID,
+	}
+
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return domain.Article{}, err
+	}
+
+	t.Author = domain.Author{
+		ID: authorID,
+	}
+	return t, nil
+}
+
+func (m *ArticleRepository) Fetch(ctx context.Context, filter domain.ArticleFilter, page, limit int) (res []domain.Article, err error) {
+	// Calculate offset for pagination
+	offset := (page - 1) * limit
+
+	conditions, args := buildArticleFilter(filter)
+	query := `SELECT ` + articleColumns + `
+  						FROM article ` + whereClause(conditions) + ` ORDER BY created_at DESC, id DESC LIMIT ? OFFSET ? `
+
+	res, err = m.fetch(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Count returns how many articles match the filter
+func (m *ArticleRepository) Count(ctx context.Context, filter domain.ArticleFilter) (total int, err error) {
+	conditions, args := buildArticleFilter(filter)
+	query := `SELECT COUNT(*) FROM article ` + whereClause(conditions)
+
+	err = m.Conn.QueryRowContext(ctx, query, args...).Scan(&total)
+	if err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
+	return total, nil
+}
+
+// buildArticleFilter turns the filter into conditions over the article table and their arguments.
+// Articles in the trash never match.
+func buildArticleFilter(filter domain.ArticleFilter) (conditions []string, args []interface{}) {
+	conditions = append(conditions, `deleted_at IS NULL`)
+
+	if filter.CategorySlug != "" {
+		if filter.IncludeDescendants {
+			// Walk down the category tree from the requested category
+			conditions = append(conditions, `EXISTS (
+				SELECT 1 FROM article_category ac
+				WHERE ac.article_id = article.id AND ac.category_id IN (
+					WITH RECURSIVE subtree AS (
+						SELECT id FROM category WHERE slug = ? AND deleted_at IS NULL
+						UNION ALL
+						SELECT c.id FROM category c INNER JOIN subtree s ON c.parent_id = s.id WHERE c.deleted_at IS NULL
+					)
+					SELECT id FROM subtree
+				)
+			)`)
+		} else {
+			conditions = append(conditions, `EXISTS (
+				SELECT 1 FROM article_category ac
+				INNER JOIN category c ON c.id = ac.category_id
+				WHERE ac.article_id = article.id AND c.slug = ? AND c.deleted_at IS NULL
+			)`)
+		}
+		args = append(args, filter.CategorySlug)
+	}
+
+	if filter.Tag != "" {
+		conditions = append(conditions, `EXISTS (
+			SELECT 1 FROM article_tag at
+			INNER JOIN tag t ON t.id = at.tag_id
+			WHERE at.article_id = article.id AND t.slug = ?
+		)`)
+		args = append(args, domain.TagSlug(filter.Tag))
+	}
+
+	if filter.AuthorID != nil {
+		conditions = append(conditions, `author_id = ?`)
+		args = append(args, *filter.AuthorID)
+	}
+
+	if filter.Published != nil {
+		conditions = append(conditions, `published = ?`)
+		args = append(args, *filter.Published)
+	}
+
+	if filter.PublishedFrom != nil {
+		conditions = append(conditions, `published_at >= ?`)
+		args = append(args, *filter.PublishedFrom)
+	}
+
+	if filter.PublishedTo != nil {
+		conditions = append(conditions, `published_at <= ?`)
+		args = append(args, *filter.PublishedTo)
+	}
+
+	if filter.VisibleAt != nil {
+		visible := `(published = 1 AND published_at IS NOT NULL AND published_at <= ?)`
+		args = append(args, *filter.VisibleAt)
+		if filter.DraftsOf != nil {
+			visible = `(` + visible + ` OR author_id = ?)`
+			args = append(args, *filter.DraftsOf)
+		}
+		conditions = append(conditions, visible)
+	}
+
+	return conditions, args
+}
+
+// whereClause joins the conditions into a WHERE clause, or nothing when there are none
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return "WHERE " + joinStrings(conditions, " AND ")
+}
+
+// FetchByCursor retrieves a page of articles after the given cursor using keyset pagination on (created_at, id),
+// starting from the newest articles when cursor is empty
+func (m *ArticleRepository) FetchByCursor(ctx context.Context, filter domain.ArticleFilter, cursor string, limit int) (res []domain.Article, page domain.PageInfo, err error) {
+	conditions, args := buildArticleFilter(filter)
+	order := keysetOrder
+
+	var c *repository.Cursor
+	if cursor != "" {
+		decoded, err := repository.DecodeCursor(cursor)
+		if err != nil {
+			return nil, domain.PageInfo{}, domain.ErrBadParamInput
+		}
+		var condition string
+		var cursorArgs []interface{}
+		condition, cursorArgs, order = keysetCondition(decoded)
+		conditions = append(conditions, condition)
+		args = append(args, cursorArgs...)
+		c = &decoded
+	}
+
+	query := `SELECT ` + articleColumns + `
+  						FROM article ` + whereClause(conditions) + ` ORDER BY ` + order + ` LIMIT ?`
+
+	res, err = m.fetch(ctx, query, append(args, limit+1)...)
+	if err != nil {
+		return nil, domain.PageInfo{}, err
+	}
+
+	res, page = keysetPage(res, limit, c, func(a domain.Article) (time.Time, uuid.UUID) {
+		return a.CreatedAt, a.ID
+	})
+	return res, page, nil
+}
+
+const keysetOrder = "created_at DESC, id DESC"
+
+// keysetCondition returns the condition selecting the rows past the cursor, along with the order to read them in.
+// Backward cursors read towards the newer rows, so they are read in ascending order.
+func keysetCondition(c repository.Cursor) (condition string, args []interface{}, order string) {
+	args = []interface{}{c.CreatedAt, c.CreatedAt, c.ID}
+	if c.Backward {
+		return "(created_at > ? OR (created_at = ? AND id > ?))", args, "created_at ASC, id ASC"
+	}
+	return "(created_at < ? OR (created_at = ? AND id < ?))", args, keysetOrder
+}
+
+// keysetPage trims rows, read with one extra row past limit, down to the page and puts it back in
+// newest-first order. It returns the cursors to the neighbouring pages.
+func keysetPage[T any](rows []T, limit int, c *repository.Cursor, key func(T) (time.Time, uuid.UUID)) ([]T, domain.PageInfo) {
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+
+	backward := c != nil && c.Backward
+	if backward {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+
+	if len(rows) == 0 {
+		return rows, domain.PageInfo{}
+	}
+
+	cursorAt := func(row T, backward bool) string {
+		createdAt, id := key(row)
+		return repository.EncodeCursor(repository.Cursor{CreatedAt: createdAt, ID: id, Backward: backward})
+	}
+
+	page := domain.PageInfo{}
+	// Going forward there is a next page when the extra row showed up, and a previous one unless this is the first page.
+	// Going backward it's the other way round.
+	if hasMore || backward {
+		page.NextCursor = cursorAt(rows[len(rows)-1], false)
+	}
+	if (hasMore && backward) || (c != nil && !backward) {
+		page.PrevCursor = cursorAt(rows[0], true)
+	}
+	return rows, page
+}
+
+// Search retrieves the articles matching the full-text query over title, short description and content,
+// ordered by relevance
+func (m *ArticleRepository) Search(ctx context.Context, q string, filter domain.ArticleFilter, page, limit int) (res []domain.ArticleSearchResult, err error) {
 	// Calculate offset for pagination
 	offset := (page - 1) * limit
 
-	query := `SELECT id, title, slug, content, thumbnail, image, short_description, meta_description, keywords, tags, reading_time_minutes, views, likes, comments, published, published_at, author_id, updated_at, created_at
-  						FROM article ORDER BY created_at DESC LIMIT ? OFFSET ? `
+	conditions, filterArgs := buildArticleFilter(filter)
+	conditions = append([]string{`MATCH(title, short_description, content) AGAINST (? IN NATURAL LANGUAGE MODE)`}, conditions...)
+	args := append([]interface{}{q, q}, filterArgs...)
 
-	res, err = m.fetch(ctx, query, limit, offset)
+	query := `SELECT ` + articleColumns + `,
+						MATCH(title, short_description, content) AGAINST (? IN NATURAL LANGUAGE MODE) AS score
+  						FROM article ` + whereClause(conditions) + `
+  						ORDER BY score DESC, created_at DESC LIMIT ? OFFSET ?`
+
+	rows, err := m.Conn.QueryContext(ctx, query, append(args, limit, offset)...)
 	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer func() {
+		errRow := rows.Close()
+		if errRow != nil {
+			logrus.Error(errRow)
+		}
+	}()
+
+	res = make([]domain.ArticleSearchResult, 0)
+	for rows.Next() {
+		var score float64
+		var t domain.Article
+		t, err = scanArticle(rows, &score)
+		if err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+		res = append(res, domain.ArticleSearchResult{
+			ArticleResponse: domain.ArticleResponse{Article: t},
+			Score:           score,
+		})
+	}
+
+	articles := make([]domain.Article, len(res))
+	for i := range res {
+		articles[i] = res[i].Article
+	}
+	if err = m.fillTags(ctx, articles); err != nil {
 		return nil, err
 	}
+	for i := range res {
+		res[i].Tags = articles[i].Tags
+	}
 
 	return res, nil
 }
+
 func (m *ArticleRepository) GetByID(ctx context.Context, id uuid.UUID) (res domain.Article, err error) {
-	query := `SELECT id, title, slug, content, thumbnail, image, short_description, meta_description, keywords, tags, reading_time_minutes, views, likes, comments, published, published_at, author_id, updated_at, created_at
-  						FROM article WHERE ID = ?`
+	query := `SELECT ` + articleColumns + `
+  						FROM article WHERE ID = ? AND deleted_at IS NULL`
 
 	list, err := m.fetch(ctx, query, id)
 	if err != nil {
@@ -99,15 +450,15 @@ func (m *ArticleRepository) GetByID(ctx context.Context, id uuid.UUID) (res doma
 	if len(list) > 0 {
 		res = list[0]
 	} else {
-		return res, fmt.Errorf("article with ID '%s' not found", id)
+		return res, domain.ErrNotFound
 	}
 
 	return
 }
 
 func (m *ArticleRepository) GetByTitle(ctx context.Context, title string) (res domain.Article, err error) {
-	query := `SELECT id, title, slug, content, thumbnail, image, short_description, meta_description, keywords, tags, reading_time_minutes, views, likes, comments, published, published_at, author_id, updated_at, created_at
-  						FROM article WHERE title = ?`
+	query := `SELECT ` + articleColumns + `
+  						FROM article WHERE title = ? AND deleted_at IS NULL`
 
 	list, err := m.fetch(ctx, query, title)
 	if err != nil {
@@ -117,14 +468,14 @@ func (m *ArticleRepository) GetByTitle(ctx context.Context, title string) (res d
 	if len(list) > 0 {
 		res = list[0]
 	} else {
-		return res, fmt.Errorf("article with title '%s' not found", title)
+		return res, domain.ErrNotFound
 	}
 	return
 }
 
 func (m *ArticleRepository) GetBySlug(ctx context.Context, slug string) (res domain.Article, err error) {
-	query := `SELECT id, title, slug, content, thumbnail, image, short_description, meta_description, keywords, tags, reading_time_minutes, views, likes, comments, published, published_at, author_id, updated_at, created_at
-  						FROM article WHERE slug = ?`
+	query := `SELECT ` + articleColumns + `
+  						FROM article WHERE slug = ? AND deleted_at IS NULL`
 
 	list, err := m.fetch(ctx, query, slug)
 	if err != nil {
@@ -134,7 +485,7 @@ func (m *ArticleRepository) GetBySlug(ctx context.Context, slug string) (res dom
 	if len(list) > 0 {
 		res = list[0]
 	} else {
-		return res, fmt.Errorf("article with slug '%s' not found", slug)
+		return res, domain.ErrNotFound
 	}
 	return
 }
@@ -153,7 +504,7 @@ func (m *ArticleRepository) Store(ctx context.Context, a *domain.Article) (err e
 		err = tx.Commit()
 	}()
 
-	query := `INSERT article SET id=?, title=?, slug=?, content=?, thumbnail=?, image=?, short_description=?, meta_description=?, keywords=?, tags=?, reading_time_minutes=?, views=?, likes=?, comments=?, published=?, published_at=?, author_id=?, updated_at=?, created_at=?`
+	query := `INSERT article SET id=?, title=?, slug=?, content=?, thumbnail=?, image=?, short_description=?, meta_description=?, keywords=?, reading_time_minutes=?, published=?, published_at=?, scheduled=?, author_id=?, updated_at=?, created_at=?, version=?, primary_category_id=?`
 	stmt, err := tx.PrepareContext(ctx, query)
 	if err != nil {
 		return
@@ -164,19 +515,21 @@ func (m *ArticleRepository) Store(ctx context.Context, a *domain.Article) (err e
 		a.ID = uuid.New()
 	}
 
-	_, err = stmt.ExecContext(ctx, a.ID, a.Title, a.Slug, a.Content, a.Thumbnail, a.Image, a.ShortDescription, a.MetaDescription, a.Keywords, a.Tags, a.ReadingTimeMinutes, a.Views, a.Likes, a.Comments, a.Published, a.PublishedAt, a.Author.ID, a.UpdatedAt, a.CreatedAt)
+	a.Version = 1
+	_, err = stmt.ExecContext(ctx, a.ID, a.Title, a.Slug, a.Content, a.Thumbnail, a.Image, a.ShortDescription, a.MetaDescription, a.Keywords, a.ReadingTimeMinutes, a.Published, a.PublishedAt, a.Scheduled, a.Author.ID, a.UpdatedAt, a.CreatedAt, a.Version, a.PrimaryCategoryID)
 	if err != nil {
 		return
 	}
 
-	// Link categories if provided, or assign default category
-	categories := a.Categories
-	if len(categories) == 0 {
-		// Assign default "Uncategorized" category
-		defaultCategoryID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
-		categories = []domain.Category{{ID: defaultCategoryID}}
+	if err = syncTags(ctx, tx, a.ID, a.Tags, a.CreatedAt); err != nil {
+		return err
 	}
 
+	if err = insertRevision(ctx, tx, a); err != nil {
+		return err
+	}
+
+	// Link categories, the service falls back to the default category when none is given
 	categoryQuery := `INSERT INTO article_category (id, article_id, category_id, created_at) VALUES (?, ?, ?, ?)`
 	categoryStmt, err := tx.PrepareContext(ctx, categoryQuery)
 	if err != nil {
@@ -184,7 +537,7 @@ func (m *ArticleRepository) Store(ctx context.Context, a *domain.Article) (err e
 	}
 	defer categoryStmt.Close()
 
-	for _, category := range categories {
+	for _, category := range a.Categories {
 		categoryLinkID := uuid.New()
 		_, err = categoryStmt.ExecContext(ctx, categoryLinkID, a.ID, category.ID, a.CreatedAt)
 		if err != nil {
@@ -195,31 +548,74 @@ func (m *ArticleRepository) Store(ctx context.Context, a *domain.Article) (err e
 	return
 }
 
-func (m *ArticleRepository) Delete(ctx context.Context, id uuid.UUID) (err error) {
-	query := "DELETE FROM article WHERE id = ?"
+// Delete moves an article to the trash, it stays out of every listing until restored or purged.
+// It fails with ErrPreconditionFailed when the article is no longer at the given version.
+func (m *ArticleRepository) Delete(ctx context.Context, id uuid.UUID, version int) (err error) {
+	query := "UPDATE article SET deleted_at = ?, version = version + 1 WHERE id = ? AND version = ? AND deleted_at IS NULL"
 
-	stmt, err := m.Conn.PrepareContext(ctx, query)
+	res, err := m.Conn.ExecContext(ctx, query, time.Now(), id, version)
 	if err != nil {
 		return
 	}
 
-	res, err := stmt.ExecContext(ctx, id)
+	rowsAfected, err := res.RowsAffected()
 	if err != nil {
 		return
 	}
 
+	if rowsAfected == 0 {
+		return domain.ErrPreconditionFailed
+	}
+
+	return
+}
+
+// FetchTrashed retrieves the articles in the trash, most recently deleted first
+func (m *ArticleRepository) FetchTrashed(ctx context.Context, page, limit int) ([]domain.Article, error) {
+	offset := (page - 1) * limit
+
+	query := `SELECT ` + articleColumns + `
+  						FROM article WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC, id DESC LIMIT ? OFFSET ?`
+
+	return m.fetch(ctx, query, limit, offset)
+}
+
+// Restore takes an article out of the trash
+func (m *ArticleRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE article SET deleted_at = NULL, updated_at = ?, version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL`
+
+	res, err := m.Conn.ExecContext(ctx, query, time.Now(), id)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
 	rowsAfected, err := res.RowsAffected()
 	if err != nil {
-		return
+		return err
 	}
 
-	if rowsAfected != 1 {
-		err = fmt.Errorf("weird  Behavior. Total Affected: %d", rowsAfected)
-		return
+	if rowsAfected == 0 {
+		return domain.ErrNotFound
 	}
 
-	return
+	return nil
 }
+
+// PurgeDeletedBefore hard-deletes the articles trashed before t, their links, comments,
+// likes and revisions go with them
+func (m *ArticleRepository) PurgeDeletedBefore(ctx context.Context, t time.Time) (int64, error) {
+	res, err := m.Conn.ExecContext(ctx, `DELETE FROM article WHERE deleted_at < ?`, t)
+	if err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+// Update saves the article when it is still at ar.Version and bumps the version,
+// it fails with ErrPreconditionFailed when someone else changed the article in between
 func (m *ArticleRepository) Update(ctx context.Context, ar *domain.Article) (err error) {
 	// Start transaction
 	tx, err := m.Conn.BeginTx(ctx, nil)
@@ -234,14 +630,14 @@ func (m *ArticleRepository) Update(ctx context.Context, ar *domain.Article) (err
 		err = tx.Commit()
 	}()
 
-	query := `UPDATE article set title=?, slug=?, content=?, thumbnail=?, image=?, short_description=?, meta_description=?, keywords=?, tags=?, reading_time_minutes=?, views=?, likes=?, comments=?, published=?, published_at=?, author_id=?, updated_at=? WHERE ID = ?`
+	query := `UPDATE article set title=?, slug=?, content=?, thumbnail=?, image=?, short_description=?, meta_description=?, keywords=?, reading_time_minutes=?, published=?, published_at=?, scheduled=?, author_id=?, updated_at=?, primary_category_id=?, version=version+1 WHERE ID = ? AND version = ? AND deleted_at IS NULL`
 
 	stmt, err := tx.PrepareContext(ctx, query)
 	if err != nil {
 		return
 	}
 
-	res, err := stmt.ExecContext(ctx, ar.Title, ar.Slug, ar.Content, ar.Thumbnail, ar.Image, ar.ShortDescription, ar.MetaDescription, ar.Keywords, ar.Tags, ar.ReadingTimeMinutes, ar.Views, ar.Likes, ar.Comments, ar.Published, ar.PublishedAt, ar.Author.ID, ar.UpdatedAt, ar.ID)
+	res, err := stmt.ExecContext(ctx, ar.Title, ar.Slug, ar.Content, ar.Thumbnail, ar.Image, ar.ShortDescription, ar.MetaDescription, ar.Keywords, ar.ReadingTimeMinutes, ar.Published, ar.PublishedAt, ar.Scheduled, ar.Author.ID, ar.UpdatedAt, ar.PrimaryCategoryID, ar.ID, ar.Version)
 	if err != nil {
 		return
 	}
@@ -249,10 +645,22 @@ func (m *ArticleRepository) Update(ctx context.Context, ar *domain.Article) (err
 	if err != nil {
 		return
 	}
+	if affect == 0 {
+		return domain.ErrPreconditionFailed
+	}
 	if affect != 1 {
 		err = fmt.Errorf("weird  Behavior. Total Affected: %d", affect)
 		return
 	}
+	ar.Version++
+
+	if err = syncTags(ctx, tx, ar.ID, ar.Tags, ar.UpdatedAt); err != nil {
+		return err
+	}
+
+	if err = insertRevision(ctx, tx, ar); err != nil {
+		return err
+	}
 
 	// Update categories if provided
 	if len(ar.Categories) > 0 {
@@ -290,6 +698,89 @@ func (m *ArticleRepository) Update(ctx context.Context, ar *domain.Article) (err
 	return
 }
 
+// IncrementViews adds the counted views to each article in a single statement
+func (m *ArticleRepository) IncrementViews(ctx context.Context, counts map[uuid.UUID]int) error {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	var cases []string
+	var placeholders []string
+	var caseArgs, idArgs []interface{}
+	for id, n := range counts {
+		cases = append(cases, "WHEN ? THEN ?")
+		caseArgs = append(caseArgs, id, n)
+		placeholders = append(placeholders, "?")
+		idArgs = append(idArgs, id)
+	}
+
+	query := `UPDATE article SET views = views + CASE id ` + joinStrings(cases, " ") + ` ELSE 0 END
+			  WHERE id IN (` + joinStrings(placeholders, ",") + `)`
+	_, err := m.Conn.ExecContext(ctx, query, append(caseArgs, idArgs...)...)
+	if err != nil {
+		logrus.Error(err)
+	}
+	return err
+}
+
+// PublishDue publishes up to limit scheduled articles whose publish date is not after now,
+// stamping them with the time it happened. The rows are claimed with FOR UPDATE SKIP LOCKED
+// so concurrent replicas never pick up the same article.
+func (m *ArticleRepository) PublishDue(ctx context.Context, now time.Time, limit int) (published int64, err error) {
+	tx, err := m.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	query := `SELECT id FROM article
+			  WHERE scheduled = 1 AND published_at <= ? AND deleted_at IS NULL
+			  ORDER BY published_at
+			  LIMIT ?
+			  FOR UPDATE SKIP LOCKED`
+
+	rows, err := tx.QueryContext(ctx, query, now, limit)
+	if err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
+
+	var placeholders []string
+	args := []interface{}{now, now}
+	for rows.Next() {
+		var id uuid.UUID
+		if err = rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		placeholders = append(placeholders, "?")
+		args = append(args, id)
+	}
+	if err = rows.Close(); err != nil {
+		return 0, err
+	}
+
+	if len(placeholders) == 0 {
+		return 0, nil
+	}
+
+	updateQuery := `UPDATE article SET published = 1, scheduled = 0, auto_published_at = ?, updated_at = ?, version = version + 1
+					WHERE id IN (` + joinStrings(placeholders, ",") + `)`
+	res, err := tx.ExecContext(ctx, updateQuery, args...)
+	if err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
 func (m *ArticleRepository) SlugExistsExcludingID(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error) {
 	query := `SELECT COUNT(*) FROM article WHERE slug = ? AND id != ?`
 	var count int
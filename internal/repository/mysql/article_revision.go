@@ -0,0 +1,114 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+const revisionColumns = `id, article_id, revision, editor_id, title, slug, content, thumbnail, image, short_description, meta_description, keywords, tags, reading_time_minutes, published, published_at, author_id, created_at`
+
+// insertRevision snapshots the saved article as its next revision, crediting the user in ctx.
+// It must run in the transaction that saved the article, whose row lock keeps the numbering sequential.
+func insertRevision(ctx context.Context, tx *sql.Tx, a *domain.Article) error {
+	var revision int
+	err := tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(revision), 0) + 1 FROM article_revision WHERE article_id = ?`, a.ID).Scan(&revision)
+	if err != nil {
+		return err
+	}
+
+	var editorID *uuid.UUID
+	if user, ok := domain.UserFromContext(ctx); ok {
+		editorID = &user.ID
+	}
+
+	query := `INSERT article_revision SET id=?, article_id=?, revision=?, editor_id=?, title=?, slug=?, content=?, thumbnail=?, image=?, short_description=?, meta_description=?, keywords=?, tags=?, reading_time_minutes=?, published=?, published_at=?, author_id=?, created_at=?`
+	_, err = tx.ExecContext(ctx, query, uuid.New(), a.ID, revision, editorID, a.Title, a.Slug, a.Content, a.Thumbnail, a.Image,
+		a.ShortDescription, a.MetaDescription, a.Keywords, a.Tags, a.ReadingTimeMinutes, a.Published, a.PublishedAt, a.Author.ID, a.UpdatedAt)
+	return err
+}
+
+func scanRevision(row rowScanner) (domain.ArticleRevision, error) {
+	r := domain.ArticleRevision{}
+	var editorID sql.NullString
+	err := row.Scan(
+		&r.ID,
+		&r.ArticleID,
+		&r.Revision,
+		&editorID,
+		&r.Title,
+		&r.Slug,
+		&r.Content,
+		&r.Thumbnail,
+		&r.Image,
+		&r.ShortDescription,
+		&r.MetaDescription,
+		&r.Keywords,
+		&r.Tags,
+		&r.ReadingTimeMinutes,
+		&r.Published,
+		&r.PublishedAt,
+		&r.AuthorID,
+		&r.CreatedAt,
+	)
+	if err != nil {
+		return domain.ArticleRevision{}, err
+	}
+
+	if editorID.Valid {
+		editorUUID, err := uuid.Parse(editorID.String)
+		if err == nil {
+			r.EditorID = &editorUUID
+		}
+	}
+	return r, nil
+}
+
+// FetchRevisions retrieves the revisions of an article, newest first
+func (m *ArticleRepository) FetchRevisions(ctx context.Context, articleID uuid.UUID) ([]domain.ArticleRevision, error) {
+	query := `SELECT ` + revisionColumns + ` FROM article_revision WHERE article_id = ? ORDER BY revision DESC`
+
+	rows, err := m.Conn.QueryContext(ctx, query, articleID)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer func() {
+		errRow := rows.Close()
+		if errRow != nil {
+			logrus.Error(errRow)
+		}
+	}()
+
+	revisions := make([]domain.ArticleRevision, 0)
+	for rows.Next() {
+		r, err := scanRevision(rows)
+		if err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+		revisions = append(revisions, r)
+	}
+
+	return revisions, rows.Err()
+}
+
+// GetRevision retrieves one revision of an article by its number
+func (m *ArticleRepository) GetRevision(ctx context.Context, articleID uuid.UUID, revision int) (domain.ArticleRevision, error) {
+	query := `SELECT ` + revisionColumns + ` FROM article_revision WHERE article_id = ? AND revision = ?`
+
+	r, err := scanRevision(m.Conn.QueryRowContext(ctx, query, articleID, revision))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.ArticleRevision{}, domain.ErrNotFound
+		}
+		logrus.Error(err)
+		return domain.ArticleRevision{}, err
+	}
+	return r, nil
+}
@@ -0,0 +1,51 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+func TestGetMissingArticle(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		name  string
+		query string
+		arg   string
+		get   func(repo *ArticleRepository) (domain.Article, error)
+	}{
+		{name: "by id", query: `FROM article WHERE ID = \?`, arg: id.String(), get: func(repo *ArticleRepository) (domain.Article, error) {
+			return repo.GetByID(context.Background(), id)
+		}},
+		{name: "by slug", query: `FROM article WHERE slug = \?`, arg: "missing", get: func(repo *ArticleRepository) (domain.Article, error) {
+			return repo.GetBySlug(context.Background(), "missing")
+		}},
+		{name: "by title", query: `FROM article WHERE title = \?`, arg: "Missing", get: func(repo *ArticleRepository) (domain.Article, error) {
+			return repo.GetByTitle(context.Background(), "Missing")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectQuery(tt.query).
+				WithArgs(tt.arg).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+			_, err = tt.get(NewArticleRepository(db))
+
+			assert.Equal(t, domain.ErrNotFound, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
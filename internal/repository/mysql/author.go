@@ -3,12 +3,19 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
 
 	"github.com/bxcodec/go-clean-arch/domain"
 )
 
+// rowScanner is implemented by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
 type AuthorRepository struct {
 	DB *sql.DB
 }
@@ -20,24 +27,239 @@ func NewAuthorRepository(db *sql.DB) *AuthorRepository {
 	}
 }
 
-func (m *AuthorRepository) getOne(ctx context.Context, query string, args ...interface{}) (res domain.Author, err error) {
-	stmt, err := m.DB.PrepareContext(ctx, query)
-	if err != nil {
-		return domain.Author{}, err
-	}
-	row := stmt.QueryRowContext(ctx, args...)
-	res = domain.Author{}
+const authorColumns = `id, name, slug, bio, avatar, social_links, created_at, updated_at`
 
-	err = row.Scan(
+// scanAuthor reads a single author row selected with authorColumns
+func scanAuthor(row rowScanner) (domain.Author, error) {
+	res := domain.Author{}
+	var bio, avatar sql.NullString
+	err := row.Scan(
 		&res.ID,
 		&res.Name,
+		&res.Slug,
+		&bio,
+		&avatar,
+		&res.SocialLinks,
 		&res.CreatedAt,
 		&res.UpdatedAt,
 	)
+	if err != nil {
+		return domain.Author{}, err
+	}
+
+	// Handle nullable profile fields
+	if bio.Valid {
+		res.Bio = bio.String
+	}
+	if avatar.Valid {
+		res.Avatar = avatar.String
+	}
+
+	return res, nil
+}
+
+func (m *AuthorRepository) getOne(ctx context.Context, query string, args ...interface{}) (res domain.Author, err error) {
+	stmt, err := m.DB.PrepareContext(ctx, query)
+	if err != nil {
+		return domain.Author{}, err
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, args...)
+	res, err = scanAuthor(row)
+	if err == sql.ErrNoRows {
+		return domain.Author{}, domain.ErrNotFound
+	}
 	return
 }
 
 func (m *AuthorRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Author, error) {
-	query := `SELECT id, name, created_at, updated_at FROM author WHERE id=?`
+	query := `SELECT ` + authorColumns + ` FROM author WHERE id=?`
 	return m.getOne(ctx, query, id)
 }
+
+// GetBySlug retrieves an author by its slug
+func (m *AuthorRepository) GetBySlug(ctx context.Context, slug string) (domain.Author, error) {
+	query := `SELECT ` + authorColumns + ` FROM author WHERE slug=?`
+	return m.getOne(ctx, query, slug)
+}
+
+// Fetch retrieves authors with pagination
+func (m *AuthorRepository) Fetch(ctx context.Context, page, limit int) ([]domain.Author, error) {
+	// Calculate offset for pagination
+	offset := (page - 1) * limit
+
+	query := `SELECT ` + authorColumns + `
+			  FROM author
+			  ORDER BY name
+			  LIMIT ? OFFSET ?`
+
+	return m.fetch(ctx, query, limit, offset)
+}
+
+// GetByIDs retrieves the authors with the given IDs in a single query
+func (m *AuthorRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]domain.Author, error) {
+	if len(ids) == 0 {
+		return []domain.Author{}, nil
+	}
+
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		placeholders[i] = "?"
+		args[i] = id
+	}
+
+	query := `SELECT ` + authorColumns + ` FROM author WHERE id IN (` + joinStrings(placeholders, ",") + `)`
+	return m.fetch(ctx, query, args...)
+}
+
+func (m *AuthorRepository) fetch(ctx context.Context, query string, args ...interface{}) ([]domain.Author, error) {
+	rows, err := m.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer func() {
+		errRow := rows.Close()
+		if errRow != nil {
+			logrus.Error(errRow)
+		}
+	}()
+
+	authors := make([]domain.Author, 0)
+	for rows.Next() {
+		var author domain.Author
+		author, err = scanAuthor(rows)
+		if err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+		authors = append(authors, author)
+	}
+
+	return authors, nil
+}
+
+// Store creates a new author
+func (m *AuthorRepository) Store(ctx context.Context, a *domain.Author) error {
+	query := `INSERT INTO author (id, name, slug, bio, avatar, social_links, created_at, updated_at)
+			  VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+	// Generate UUID if not set
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+
+	now := time.Now()
+	a.CreatedAt = now
+	a.UpdatedAt = now
+
+	_, err := m.DB.ExecContext(ctx, query, a.ID, a.Name, a.Slug, a.Bio, a.Avatar, a.SocialLinks, a.CreatedAt, a.UpdatedAt)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// Update modifies an existing author
+func (m *AuthorRepository) Update(ctx context.Context, a *domain.Author) error {
+	query := `UPDATE author
+			  SET name = ?, slug = ?, bio = ?, avatar = ?, social_links = ?, updated_at = ?
+			  WHERE id = ?`
+
+	a.UpdatedAt = time.Now()
+
+	result, err := m.DB.ExecContext(ctx, query, a.Name, a.Slug, a.Bio, a.Avatar, a.SocialLinks, a.UpdatedAt, a.ID)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// SlugExistsExcludingID checks if a slug exists for a different author
+func (m *AuthorRepository) SlugExistsExcludingID(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error) {
+	query := `SELECT COUNT(*) FROM author WHERE slug = ? AND id != ?`
+	var count int
+	err := m.DB.QueryRowContext(ctx, query, slug, excludeID).Scan(&count)
+	return count > 0, err
+}
+
+// Delete removes an author. When reassignTo is set, the author's articles are
+// moved to that author within the same transaction before the row is deleted,
+// so the ON DELETE CASCADE on article.author_id never fires for them.
+// Otherwise it fails with ErrAuthorHasArticles while the author still has articles,
+// the author row stays locked from the count to the delete so none can be added in between.
+func (m *AuthorRepository) Delete(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) (err error) {
+	tx, err := m.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	var locked uuid.UUID
+	err = tx.QueryRowContext(ctx, `SELECT id FROM author WHERE id = ? FOR UPDATE`, id).Scan(&locked)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.ErrNotFound
+		}
+		logrus.Error(err)
+		return err
+	}
+
+	if reassignTo != nil {
+		_, err = tx.ExecContext(ctx, `UPDATE article SET author_id = ? WHERE author_id = ?`, *reassignTo, id)
+		if err != nil {
+			logrus.Error(err)
+			return err
+		}
+	} else {
+		var count int
+		err = tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM article WHERE author_id = ?`, id).Scan(&count)
+		if err != nil {
+			logrus.Error(err)
+			return err
+		}
+		if count > 0 {
+			return domain.ErrAuthorHasArticles
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM author WHERE id = ?`, id)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected != 1 {
+		err = domain.ErrNotFound
+		return err
+	}
+
+	return nil
+}
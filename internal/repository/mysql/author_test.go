@@ -0,0 +1,58 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+func TestAuthorDeleteRefusesAuthorWithArticles(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	id := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id FROM author WHERE id = \\? FOR UPDATE").
+		WithArgs(id.String()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id.String()))
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM article WHERE author_id = \\?").
+		WithArgs(id.String()).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectRollback()
+
+	err = NewAuthorRepository(db).Delete(context.Background(), id, nil)
+
+	assert.Equal(t, domain.ErrAuthorHasArticles, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuthorDeleteWithoutArticles(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	id := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id FROM author WHERE id = \\? FOR UPDATE").
+		WithArgs(id.String()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id.String()))
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM article WHERE author_id = \\?").
+		WithArgs(id.String()).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec("DELETE FROM author WHERE id = \\?").
+		WithArgs(id.String()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = NewAuthorRepository(db).Delete(context.Background(), id, nil)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -4,14 +4,18 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
 	"github.com/bxcodec/go-clean-arch/domain"
+	"github.com/bxcodec/go-clean-arch/internal/repository"
 )
 
+const categoryColumns = `id, name, slug, description, image, parent_id, created_at, updated_at, deleted_at`
+
 type CategoryRepository struct {
 	Conn *sql.DB
 }
@@ -21,17 +25,44 @@ func NewCategoryRepository(conn *sql.DB) *CategoryRepository {
 	return &CategoryRepository{conn}
 }
 
-// GetByArticleID fetches all categories for a specific article
-func (m *CategoryRepository) GetByArticleID(ctx context.Context, articleID uuid.UUID) ([]domain.Category, error) {
-	query := `
-		SELECT c.id, c.name, c.slug, c.description, c.image, c.parent_id, c.created_at, c.updated_at
-		FROM category c
-		INNER JOIN article_category ac ON c.id = ac.category_id
-		WHERE ac.article_id = ?
-		ORDER BY c.name
-	`
+// scanCategory reads a category row selected with the category columns, followed by the extra columns if any
+func scanCategory(row rowScanner, extra ...interface{}) (domain.Category, error) {
+	category := domain.Category{}
+	var parentID, image sql.NullString
+	dest := []interface{}{
+		&category.ID,
+		&category.Name,
+		&category.Slug,
+		&category.Description,
+		&image,
+		&parentID,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+		&category.DeletedAt,
+	}
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return domain.Category{}, err
+	}
+
+	// Handle image
+	if image.Valid {
+		category.Image = image.String
+	}
 
-	rows, err := m.Conn.QueryContext(ctx, query, articleID)
+	// Handle parent_id
+	if parentID.Valid {
+		parentUUID, err := uuid.Parse(parentID.String)
+		if err == nil {
+			category.ParentID = &parentUUID
+		}
+	}
+
+	return category, nil
+}
+
+func (m *CategoryRepository) fetch(ctx context.Context, query string, args ...interface{}) ([]domain.Category, error) {
+	rows, err := m.Conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		logrus.Error(err)
 		return nil, err
@@ -46,62 +77,62 @@ func (m *CategoryRepository) GetByArticleID(ctx context.Context, articleID uuid.
 
 	var categories []domain.Category
 	for rows.Next() {
-		category := domain.Category{}
-		var parentID, image sql.NullString
-		err = rows.Scan(
-			&category.ID,
-			&category.Name,
-			&category.Slug,
-			&category.Description,
-			&image,
-			&parentID,
-			&category.CreatedAt,
-			&category.UpdatedAt,
-		)
-
+		category, err := scanCategory(rows)
 		if err != nil {
 			logrus.Error(err)
 			return nil, err
 		}
+		categories = append(categories, category)
+	}
 
-		// Handle image
-		if image.Valid {
-			category.Image = image.String
-		}
+	return categories, nil
+}
 
-		// Handle parent_id
-		if parentID.Valid {
-			parentUUID, err := uuid.Parse(parentID.String)
-			if err == nil {
-				category.ParentID = &parentUUID
-			}
+func (m *CategoryRepository) getOne(ctx context.Context, query string, args ...interface{}) (domain.Category, error) {
+	category, err := scanCategory(m.Conn.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Category{}, domain.ErrNotFound
 		}
-
-		categories = append(categories, category)
+		logrus.Error(err)
+		return domain.Category{}, err
 	}
+	return category, nil
+}
 
-	return categories, nil
+// GetByArticleID fetches all categories for a specific article
+func (m *CategoryRepository) GetByArticleID(ctx context.Context, articleID uuid.UUID) ([]domain.Category, error) {
+	query := `
+		SELECT c.id, c.name, c.slug, c.description, c.image, c.parent_id, c.created_at, c.updated_at, c.deleted_at
+		FROM category c
+		INNER JOIN article_category ac ON c.id = ac.category_id
+		WHERE ac.article_id = ? AND c.deleted_at IS NULL
+		ORDER BY c.name
+	`
+
+	return m.fetch(ctx, query, articleID)
 }
 
-// GetByIDs fetches categories by their IDs
-func (m *CategoryRepository) GetByIDs(ctx context.Context, categoryIDs []uuid.UUID) ([]domain.Category, error) {
-	if len(categoryIDs) == 0 {
-		return []domain.Category{}, nil
+// GetByArticleIDs fetches the categories of all the given articles in a single query, keyed by article ID
+func (m *CategoryRepository) GetByArticleIDs(ctx context.Context, articleIDs []uuid.UUID) (map[uuid.UUID][]domain.Category, error) {
+	res := make(map[uuid.UUID][]domain.Category, len(articleIDs))
+	if len(articleIDs) == 0 {
+		return res, nil
 	}
 
-	// Build the query with placeholders for IN clause
-	placeholders := make([]string, len(categoryIDs))
-	args := make([]interface{}, len(categoryIDs))
-	for i, id := range categoryIDs {
+	placeholders := make([]string, len(articleIDs))
+	args := make([]interface{}, len(articleIDs))
+	for i, id := range articleIDs {
 		placeholders[i] = "?"
 		args[i] = id
 	}
 
 	query := `
-		SELECT id, name, slug, description, image, parent_id, created_at, updated_at
-		FROM category
-		WHERE id IN (` + joinStrings(placeholders, ",") + `)
-		ORDER BY name
+		SELECT c.id, c.name, c.slug, c.description, c.image, c.parent_id, c.created_at, c.updated_at, c.deleted_at, ac.article_id
+		FROM category c
+		INNER JOIN article_category ac ON c.id = ac.category_id
+		WHERE ac.article_id IN (` + joinStrings(placeholders, ",") + `) AND c.deleted_at IS NULL
+		ORDER BY c.name
 	`
 
 	rows, err := m.Conn.QueryContext(ctx, query, args...)
@@ -117,56 +148,57 @@ func (m *CategoryRepository) GetByIDs(ctx context.Context, categoryIDs []uuid.UU
 		}
 	}()
 
-	var categories []domain.Category
 	for rows.Next() {
-		category := domain.Category{}
-		var parentID, image sql.NullString
-		err = rows.Scan(
-			&category.ID,
-			&category.Name,
-			&category.Slug,
-			&category.Description,
-			&image,
-			&parentID,
-			&category.CreatedAt,
-			&category.UpdatedAt,
-		)
-
+		var articleID uuid.UUID
+		category, err := scanCategory(rows, &articleID)
 		if err != nil {
 			logrus.Error(err)
 			return nil, err
 		}
+		res[articleID] = append(res[articleID], category)
+	}
 
-		// Handle image
-		if image.Valid {
-			category.Image = image.String
-		}
+	return res, rows.Err()
+}
 
-		// Handle parent_id
-		if parentID.Valid {
-			parentUUID, err := uuid.Parse(parentID.String)
-			if err == nil {
-				category.ParentID = &parentUUID
-			}
-		}
+// maxCategoryDepth bounds the ancestor walk, well beyond any real category tree
+const maxCategoryDepth = 100
 
-		categories = append(categories, category)
+// GetAncestors fetches the ancestor chain of each of the given categories in a single query, keyed by category ID.
+// Every chain runs from the root down to the category itself, with Level and Path filled.
+func (m *CategoryRepository) GetAncestors(ctx context.Context, categoryIDs []uuid.UUID) (map[uuid.UUID][]domain.Category, error) {
+	res := make(map[uuid.UUID][]domain.Category, len(categoryIDs))
+	if len(categoryIDs) == 0 {
+		return res, nil
 	}
 
-	return categories, nil
-}
-
-// Fetch retrieves categories with pagination
-func (m *CategoryRepository) Fetch(ctx context.Context, page, limit int) ([]domain.Category, error) {
-	// Calculate offset for pagination
-	offset := (page - 1) * limit
+	placeholders := make([]string, len(categoryIDs))
+	args := make([]interface{}, len(categoryIDs))
+	for i, id := range categoryIDs {
+		placeholders[i] = "?"
+		args[i] = id
+	}
 
-	query := `SELECT id, name, slug, description, image, parent_id, created_at, updated_at
-			  FROM category 
-			  ORDER BY created_at DESC
-			  LIMIT ? OFFSET ?`
+	// visited stops the walk at a category already in the chain, so a parent_id loop ends instead
+	// of running into cte_max_recursion_depth
+	query := `
+		WITH RECURSIVE chain AS (
+			SELECT id AS origin, id, parent_id, 0 AS distance, CAST(id AS CHAR(4000)) AS visited
+			FROM category
+			WHERE id IN (` + joinStrings(placeholders, ",") + `) AND deleted_at IS NULL
+			UNION ALL
+			SELECT chain.origin, c.id, c.parent_id, chain.distance + 1, CONCAT(chain.visited, ',', c.id)
+			FROM category c
+			INNER JOIN chain ON c.id = chain.parent_id
+			WHERE c.deleted_at IS NULL AND FIND_IN_SET(c.id, chain.visited) = 0 AND chain.distance < ` + strconv.Itoa(maxCategoryDepth) + `
+		)
+		SELECT c.id, c.name, c.slug, c.description, c.image, c.parent_id, c.created_at, c.updated_at, c.deleted_at, chain.origin
+		FROM chain
+		INNER JOIN category c ON c.id = chain.id
+		ORDER BY chain.origin, chain.distance DESC
+	`
 
-	rows, err := m.Conn.QueryContext(ctx, query, limit, offset)
+	rows, err := m.Conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		logrus.Error(err)
 		return nil, err
@@ -179,128 +211,138 @@ func (m *CategoryRepository) Fetch(ctx context.Context, page, limit int) ([]doma
 		}
 	}()
 
-	var categories []domain.Category
 	for rows.Next() {
-		category := domain.Category{}
-		var parentID sql.NullString
-		var image sql.NullString
-		err = rows.Scan(
-			&category.ID,
-			&category.Name,
-			&category.Slug,
-			&category.Description,
-			&image,
-			&parentID,
-			&category.CreatedAt,
-			&category.UpdatedAt,
-		)
-
+		var origin uuid.UUID
+		category, err := scanCategory(rows, &origin)
 		if err != nil {
 			logrus.Error(err)
 			return nil, err
 		}
+		res[origin] = append(res[origin], category)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
 
-		// Handle image
-		if image.Valid {
-			category.Image = image.String
-		}
+	for _, chain := range res {
+		fillChainPaths(chain)
+	}
+	return res, nil
+}
 
-		// Handle parent_id
-		if parentID.Valid {
-			parentUUID, err := uuid.Parse(parentID.String)
-			if err == nil {
-				category.ParentID = &parentUUID
-			}
+// fillChainPaths sets the Level and Path of an ancestor chain ordered from the root down
+func fillChainPaths(chain []domain.Category) {
+	path := ""
+	for i := range chain {
+		if i == 0 {
+			path = chain[i].Slug
+		} else {
+			path += "/" + chain[i].Slug
 		}
+		chain[i].Level = i + 1
+		chain[i].Path = path
+	}
+}
 
-		categories = append(categories, category)
+// GetByIDs fetches categories by their IDs
+func (m *CategoryRepository) GetByIDs(ctx context.Context, categoryIDs []uuid.UUID) ([]domain.Category, error) {
+	if len(categoryIDs) == 0 {
+		return []domain.Category{}, nil
 	}
 
-	return categories, nil
+	// Build the query with placeholders for IN clause
+	placeholders := make([]string, len(categoryIDs))
+	args := make([]interface{}, len(categoryIDs))
+	for i, id := range categoryIDs {
+		placeholders[i] = "?"
+		args[i] = id
+	}
+
+	query := `
+		SELECT ` + categoryColumns + `
+		FROM category
+		WHERE id IN (` + joinStrings(placeholders, ",") + `) AND deleted_at IS NULL
+		ORDER BY name
+	`
+
+	return m.fetch(ctx, query, args...)
 }
 
-// GetBySlug retrieves a category by its slug
-func (m *CategoryRepository) GetBySlug(ctx context.Context, slug string) (domain.Category, error) {
-	query := `SELECT id, name, slug, description, image, parent_id, created_at, updated_at
-			  FROM category 
-			  WHERE slug = ?`
+// Fetch retrieves categories with pagination
+func (m *CategoryRepository) Fetch(ctx context.Context, page, limit int) ([]domain.Category, error) {
+	// Calculate offset for pagination
+	offset := (page - 1) * limit
 
-	row := m.Conn.QueryRowContext(ctx, query, slug)
+	query := `SELECT ` + categoryColumns + `
+			  FROM category 
+			  WHERE deleted_at IS NULL
+			  ORDER BY created_at DESC, id DESC
+			  LIMIT ? OFFSET ?`
 
-	category := domain.Category{}
-	var parentID sql.NullString
-	err := row.Scan(
-		&category.ID,
-		&category.Name,
-		&category.Slug,
-		&category.Description,
-		&parentID,
-		&category.CreatedAt,
-		&category.UpdatedAt,
-	)
+	return m.fetch(ctx, query, limit, offset)
+}
 
+// Count returns the number of categories
+func (m *CategoryRepository) Count(ctx context.Context) (total int, err error) {
+	err = m.Conn.QueryRowContext(ctx, `SELECT COUNT(*) FROM category WHERE deleted_at IS NULL`).Scan(&total)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return domain.Category{}, fmt.Errorf("category with slug '%s' not found", slug)
-		}
 		logrus.Error(err)
-		return domain.Category{}, err
+		return 0, err
 	}
+	return total, nil
+}
 
-	// Handle parent_id
-	if parentID.Valid {
-		parentUUID, err := uuid.Parse(parentID.String)
-		if err == nil {
-			category.ParentID = &parentUUID
+// FetchByCursor retrieves a page of categories after the given cursor using keyset pagination on (created_at, id),
+// starting from the newest categories when cursor is empty
+func (m *CategoryRepository) FetchByCursor(ctx context.Context, cursor string, limit int) ([]domain.Category, domain.PageInfo, error) {
+	where := "WHERE deleted_at IS NULL"
+	order := keysetOrder
+	var args []interface{}
+
+	var c *repository.Cursor
+	if cursor != "" {
+		decoded, err := repository.DecodeCursor(cursor)
+		if err != nil {
+			return nil, domain.PageInfo{}, domain.ErrBadParamInput
 		}
+		var condition string
+		condition, args, order = keysetCondition(decoded)
+		where += " AND " + condition
+		c = &decoded
 	}
 
-	return category, nil
-}
-
-// GetByID retrieves a category by its ID
-func (m *CategoryRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Category, error) {
-	query := `SELECT id, name, slug, description, image, parent_id, created_at, updated_at
-			  FROM category 
-			  WHERE id = ?`
-
-	row := m.Conn.QueryRowContext(ctx, query, id)
-
-	category := domain.Category{}
-	var parentID, image sql.NullString
-	err := row.Scan(
-		&category.ID,
-		&category.Name,
-		&category.Slug,
-		&category.Description,
-		&image,
-		&parentID,
-		&category.CreatedAt,
-		&category.UpdatedAt,
-	)
+	query := `SELECT ` + categoryColumns + `
+			  FROM category ` + where + `
+			  ORDER BY ` + order + `
+			  LIMIT ?`
 
+	categories, err := m.fetch(ctx, query, append(args, limit+1)...)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return domain.Category{}, fmt.Errorf("category with ID '%s' not found", id)
-		}
-		logrus.Error(err)
-		return domain.Category{}, err
+		return nil, domain.PageInfo{}, err
 	}
 
-	// Handle image
-	if image.Valid {
-		category.Image = image.String
-	}
+	categories, page := keysetPage(categories, limit, c, func(cat domain.Category) (time.Time, uuid.UUID) {
+		return cat.CreatedAt, cat.ID
+	})
+	return categories, page, nil
+}
 
-	// Handle parent_id
-	if parentID.Valid {
-		parentUUID, err := uuid.Parse(parentID.String)
-		if err == nil {
-			category.ParentID = &parentUUID
-		}
-	}
+// GetBySlug retrieves a category by its slug
+func (m *CategoryRepository) GetBySlug(ctx context.Context, slug string) (domain.Category, error) {
+	query := `SELECT ` + categoryColumns + `
+			  FROM category 
+			  WHERE slug = ? AND deleted_at IS NULL`
 
-	return category, nil
+	return m.getOne(ctx, query, slug)
+}
+
+// GetByID retrieves a category by its ID
+func (m *CategoryRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Category, error) {
+	query := `SELECT ` + categoryColumns + `
+			  FROM category 
+			  WHERE id = ? AND deleted_at IS NULL`
+
+	return m.getOne(ctx, query, id)
 }
 
 // Store creates a new category
@@ -336,15 +378,43 @@ func (m *CategoryRepository) Store(ctx context.Context, category *domain.Categor
 	return nil
 }
 
-// Update modifies an existing category
-func (m *CategoryRepository) Update(ctx context.Context, category *domain.Category) error {
+// Update modifies an existing category. A new parent goes through the same checks as Move,
+// in the same transaction as the other fields so either all of them change or none does.
+func (m *CategoryRepository) Update(ctx context.Context, category *domain.Category) (err error) {
+	tx, err := m.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	var current sql.NullString
+	err = tx.QueryRowContext(ctx, `SELECT parent_id FROM category WHERE id = ? AND deleted_at IS NULL FOR UPDATE`, category.ID).Scan(&current)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.ErrNotFound
+		}
+		logrus.Error(err)
+		return err
+	}
+	if category.ParentID != nil && (!current.Valid || current.String != category.ParentID.String()) {
+		if err = checkAncestors(ctx, tx, category.ID, *category.ParentID); err != nil {
+			return err
+		}
+	}
+
 	query := `UPDATE category 
 			  SET name = ?, slug = ?, description = ?, image = ?, parent_id = ?, updated_at = ?
 			  WHERE id = ?`
 
 	category.UpdatedAt = time.Now()
 
-	result, err := m.Conn.ExecContext(ctx, query,
+	result, err := tx.ExecContext(ctx, query,
 		category.Name,
 		category.Slug,
 		category.Description,
@@ -371,162 +441,341 @@ func (m *CategoryRepository) Update(ctx context.Context, category *domain.Catego
 	return nil
 }
 
-// Delete removes a category
-func (m *CategoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM category WHERE id = ?`
-
-	result, err := m.Conn.ExecContext(ctx, query, id)
+// Move puts the category, along with its subtree, under parentID, or at the root when parentID is nil.
+// The ancestors of the new parent are read with shared locks, so concurrent moves can't build a cycle
+// between them, and the move fails with ErrCategoryCycle when the category is one of those ancestors.
+func (m *CategoryRepository) Move(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) (err error) {
+	tx, err := m.Conn.BeginTx(ctx, nil)
 	if err != nil {
-		logrus.Error(err)
 		return err
 	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+		err = tx.Commit()
+	}()
 
-	rowsAffected, err := result.RowsAffected()
+	var locked uuid.UUID
+	err = tx.QueryRowContext(ctx, `SELECT id FROM category WHERE id = ? AND deleted_at IS NULL FOR UPDATE`, id).Scan(&locked)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.ErrNotFound
+		}
+		logrus.Error(err)
 		return err
 	}
 
-	if rowsAffected == 0 {
-		return fmt.Errorf("category with ID '%s' not found", id)
+	if parentID != nil {
+		if err = checkAncestors(ctx, tx, id, *parentID); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	_, err = tx.ExecContext(ctx, `UPDATE category SET parent_id = ?, updated_at = ? WHERE id = ?`, parentID, time.Now(), id)
+	if err != nil {
+		logrus.Error(err)
+	}
+	return err
 }
 
-// SlugExistsExcludingID checks if a slug exists for a different category
-func (m *CategoryRepository) SlugExistsExcludingID(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error) {
-	query := `SELECT COUNT(*) FROM category WHERE slug = ? AND id != ?`
-	var count int
-	err := m.Conn.QueryRowContext(ctx, query, slug, excludeID).Scan(&count)
-	return count > 0, err
-}
+// checkAncestors walks up from parentID to the root, failing when it meets id. The parent has to be a live category.
+func checkAncestors(ctx context.Context, tx *sql.Tx, id, parentID uuid.UUID) error {
+	visited := map[uuid.UUID]bool{}
+	current := &parentID
+	for current != nil && !visited[*current] {
+		if *current == id {
+			return domain.ErrCategoryCycle
+		}
+		visited[*current] = true
 
-// GetChildren retrieves all children of a category
-func (m *CategoryRepository) GetChildren(ctx context.Context, parentID uuid.UUID) ([]domain.Category, error) {
-	query := `SELECT id, name, slug, description, image, parent_id, created_at, updated_at
-			  FROM category 
-			  WHERE parent_id = ?
-			  ORDER BY name`
+		var next sql.NullString
+		var deletedAt *time.Time
+		err := tx.QueryRowContext(ctx, `SELECT parent_id, deleted_at FROM category WHERE id = ? FOR SHARE`, *current).Scan(&next, &deletedAt)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return domain.ErrBadParamInput
+			}
+			logrus.Error(err)
+			return err
+		}
+		if *current == parentID && deletedAt != nil {
+			return domain.ErrBadParamInput
+		}
 
-	rows, err := m.Conn.QueryContext(ctx, query, parentID)
-	if err != nil {
-		logrus.Error(err)
-		return nil, err
+		current = nil
+		if next.Valid {
+			nextID, err := uuid.Parse(next.String)
+			if err != nil {
+				return err
+			}
+			current = &nextID
+		}
 	}
+	return nil
+}
 
+// Delete moves a category to the trash once its children and articles are handed over as the deletion says,
+// so the trashed category is always left empty
+func (m *CategoryRepository) Delete(ctx context.Context, id uuid.UUID, deletion domain.CategoryDeletion) (err error) {
+	tx, err := m.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
 	defer func() {
-		errRow := rows.Close()
-		if errRow != nil {
-			logrus.Error(errRow)
+		if err != nil {
+			tx.Rollback()
+			return
 		}
+		err = tx.Commit()
 	}()
 
-	var categories []domain.Category
-	for rows.Next() {
-		category := domain.Category{}
-		var parentID, image sql.NullString
-		err = rows.Scan(
-			&category.ID,
-			&category.Name,
-			&category.Slug,
-			&category.Description,
-			&image,
-			&parentID,
-			&category.CreatedAt,
-			&category.UpdatedAt,
-		)
-
-		if err != nil {
-			logrus.Error(err)
-			return nil, err
+	var parent sql.NullString
+	err = tx.QueryRowContext(ctx, `SELECT parent_id FROM category WHERE id = ? AND deleted_at IS NULL FOR UPDATE`, id).Scan(&parent)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.ErrNotFound
 		}
+		logrus.Error(err)
+		return err
+	}
 
-		// Handle image
-		if image.Valid {
-			category.Image = image.String
+	now := time.Now()
+	switch deletion.Strategy {
+	case domain.CategoryDeleteToParent:
+		if !parent.Valid {
+			err = reassignCategoryContent(ctx, tx, id, nil, deletion.FallbackID, now)
+			break
 		}
-
-		// Handle parent_id
-		if parentID.Valid {
-			parentUUID, err := uuid.Parse(parentID.String)
-			if err == nil {
-				category.ParentID = &parentUUID
-			}
+		var parentID uuid.UUID
+		if parentID, err = uuid.Parse(parent.String); err != nil {
+			return err
+		}
+		err = reassignCategoryContent(ctx, tx, id, &parentID, parentID, now)
+	case domain.CategoryDeleteMoveTo:
+		if deletion.TargetID == nil {
+			return domain.ErrBadParamInput
 		}
+		// The children can't go under one of their own descendants
+		if err = checkAncestors(ctx, tx, id, *deletion.TargetID); err != nil {
+			return err
+		}
+		err = reassignCategoryContent(ctx, tx, id, deletion.TargetID, *deletion.TargetID, now)
+	default:
+		var children, articles int
+		err = tx.QueryRowContext(ctx, `SELECT
+				(SELECT COUNT(*) FROM category WHERE parent_id = ? AND deleted_at IS NULL),
+				(SELECT COUNT(*) FROM article_category WHERE category_id = ?)`, id, id).Scan(&children, &articles)
+		if err == nil && children+articles > 0 {
+			err = domain.ErrCategoryNotEmpty
+		}
+	}
+	if err != nil {
+		return err
+	}
 
-		categories = append(categories, category)
+	_, err = tx.ExecContext(ctx, `UPDATE category SET deleted_at = ? WHERE id = ?`, now, id)
+	if err != nil {
+		logrus.Error(err)
 	}
+	return err
+}
 
-	return categories, nil
+// reassignCategoryContent puts the children of the category under parentID, or at the root when it is nil,
+// and moves its articles to categoryID, skipping the articles already linked there.
+// The articles having it as their primary category get categoryID instead.
+func reassignCategoryContent(ctx context.Context, tx *sql.Tx, id uuid.UUID, parentID *uuid.UUID, categoryID uuid.UUID, now time.Time) error {
+	_, err := tx.ExecContext(ctx, `UPDATE category SET parent_id = ?, updated_at = ? WHERE parent_id = ?`, parentID, now, id)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO article_category (id, article_id, category_id, created_at)
+		SELECT UUID(), ac.article_id, ?, ? FROM article_category ac
+		WHERE ac.category_id = ? AND NOT EXISTS (
+			SELECT 1 FROM article_category existing
+			WHERE existing.article_id = ac.article_id AND existing.category_id = ?
+		)`, categoryID, now, id, categoryID)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM article_category WHERE category_id = ?`, id)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE article SET primary_category_id = ? WHERE primary_category_id = ?`, categoryID, id)
+	if err != nil {
+		logrus.Error(err)
+	}
+	return err
 }
 
-// GetRootCategories retrieves all root categories (no parent)
-func (m *CategoryRepository) GetRootCategories(ctx context.Context) ([]domain.Category, error) {
-	query := `SELECT id, name, slug, description, image, parent_id, created_at, updated_at
+// FetchTrashed retrieves the categories in the trash, most recently deleted first
+func (m *CategoryRepository) FetchTrashed(ctx context.Context, page, limit int) ([]domain.Category, error) {
+	offset := (page - 1) * limit
+
+	query := `SELECT ` + categoryColumns + `
 			  FROM category 
-			  WHERE parent_id IS NULL
-			  ORDER BY name`
+			  WHERE deleted_at IS NOT NULL
+			  ORDER BY deleted_at DESC, id DESC
+			  LIMIT ? OFFSET ?`
+
+	return m.fetch(ctx, query, limit, offset)
+}
 
-	rows, err := m.Conn.QueryContext(ctx, query)
+// RestoreBySlug takes the category holding slug out of the trash
+func (m *CategoryRepository) RestoreBySlug(ctx context.Context, slug string) error {
+	query := `UPDATE category SET deleted_at = NULL, updated_at = ? WHERE slug = ? AND deleted_at IS NOT NULL`
+
+	result, err := m.Conn.ExecContext(ctx, query, time.Now(), slug)
 	if err != nil {
 		logrus.Error(err)
-		return nil, err
+		return err
 	}
 
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// Restore takes a category out of the trash
+func (m *CategoryRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE category SET deleted_at = NULL, updated_at = ? WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := m.Conn.ExecContext(ctx, query, time.Now(), id)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// PurgeDeletedBefore hard-deletes the categories trashed before t along with their article links
+func (m *CategoryRepository) PurgeDeletedBefore(ctx context.Context, t time.Time) (purged int64, err error) {
+	tx, err := m.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
 	defer func() {
-		errRow := rows.Close()
-		if errRow != nil {
-			logrus.Error(errRow)
+		if err != nil {
+			tx.Rollback()
+			return
 		}
+		err = tx.Commit()
 	}()
 
-	var categories []domain.Category
-	for rows.Next() {
-		category := domain.Category{}
-		var parentID, image sql.NullString
-		err = rows.Scan(
-			&category.ID,
-			&category.Name,
-			&category.Slug,
-			&category.Description,
-			&image,
-			&parentID,
-			&category.CreatedAt,
-			&category.UpdatedAt,
-		)
+	_, err = tx.ExecContext(ctx, `DELETE ac FROM article_category ac
+		INNER JOIN category c ON c.id = ac.category_id
+		WHERE c.deleted_at < ?`, t)
+	if err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
 
-		if err != nil {
-			logrus.Error(err)
-			return nil, err
-		}
+	result, err := tx.ExecContext(ctx, `DELETE FROM category WHERE deleted_at < ?`, t)
+	if err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
 
-		// Handle image
-		if image.Valid {
-			category.Image = image.String
-		}
+	return result.RowsAffected()
+}
 
-		// Handle parent_id
-		if parentID.Valid {
-			parentUUID, err := uuid.Parse(parentID.String)
-			if err == nil {
-				category.ParentID = &parentUUID
-			}
-		}
+// SlugExistsExcludingID checks if a slug exists for a different category, trashed ones included
+// as they keep their slug until purged
+func (m *CategoryRepository) SlugExistsExcludingID(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error) {
+	query := `SELECT COUNT(*) FROM category WHERE slug = ? AND id != ?`
+	var count int
+	err := m.Conn.QueryRowContext(ctx, query, slug, excludeID).Scan(&count)
+	return count > 0, err
+}
 
-		categories = append(categories, category)
-	}
+// GetChildren retrieves all children of a category
+func (m *CategoryRepository) GetChildren(ctx context.Context, parentID uuid.UUID) ([]domain.Category, error) {
+	query := `SELECT ` + categoryColumns + `
+			  FROM category 
+			  WHERE parent_id = ? AND deleted_at IS NULL
+			  ORDER BY name`
 
-	return categories, nil
+	return m.fetch(ctx, query, parentID)
 }
 
-// GetCategoryTree retrieves the complete category tree
-func (m *CategoryRepository) GetCategoryTree(ctx context.Context) ([]domain.Category, error) {
-	// First get all categories
-	query := `SELECT id, name, slug, description, image, parent_id, created_at, updated_at
+// GetRootCategories retrieves all root categories (no parent)
+func (m *CategoryRepository) GetRootCategories(ctx context.Context) ([]domain.Category, error) {
+	query := `SELECT ` + categoryColumns + `
 			  FROM category 
+			  WHERE parent_id IS NULL AND deleted_at IS NULL
 			  ORDER BY name`
 
-	rows, err := m.Conn.QueryContext(ctx, query)
+	return m.fetch(ctx, query)
+}
+
+// categoryTree walks the category tree down from the root categories, numbering the levels from 1
+// and joining the slugs into the path. Trashed categories hide their subtree.
+const categoryTree = `
+	WITH RECURSIVE tree AS (
+		SELECT ` + categoryColumns + `, 1 AS level, CAST(slug AS CHAR(1000)) AS path
+		FROM category
+		WHERE parent_id IS NULL AND deleted_at IS NULL
+		UNION ALL
+		SELECT c.id, c.name, c.slug, c.description, c.image, c.parent_id, c.created_at, c.updated_at, c.deleted_at,
+			t.level + 1, CONCAT(t.path, '/', c.slug)
+		FROM category c
+		INNER JOIN tree t ON c.parent_id = t.id
+		WHERE c.deleted_at IS NULL
+	)`
+
+// GetCategoryTree retrieves the category tree, or the subtree of the category with the rootSlug when given.
+// A positive depth limits how many levels are returned, counting the level of the first categories.
+func (m *CategoryRepository) GetCategoryTree(ctx context.Context, rootSlug string, depth int) ([]domain.Category, error) {
+	var query string
+	var args []interface{}
+	if rootSlug == "" {
+		query = categoryTree + `
+			SELECT tree.* FROM tree`
+		if depth > 0 {
+			query += ` WHERE tree.level <= ?`
+			args = append(args, depth)
+		}
+	} else {
+		query = categoryTree + `, root AS (
+				SELECT path, level FROM tree WHERE slug = ?
+			)
+			SELECT tree.* FROM tree
+			INNER JOIN root ON tree.path = root.path
+				OR LEFT(tree.path, CHAR_LENGTH(root.path) + 1) = CONCAT(root.path, '/')`
+		args = append(args, rootSlug)
+		if depth > 0 {
+			query += ` WHERE tree.level < root.level + ?`
+			args = append(args, depth)
+		}
+	}
+	query += ` ORDER BY tree.level, tree.name`
+
+	rows, err := m.Conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		logrus.Error(err)
 		return nil, err
@@ -539,79 +788,62 @@ func (m *CategoryRepository) GetCategoryTree(ctx context.Context) ([]domain.Cate
 		}
 	}()
 
-	var allCategories []domain.Category
+	var categories []domain.Category
 	for rows.Next() {
-		category := domain.Category{}
-		var parentID, image sql.NullString
-		err = rows.Scan(
-			&category.ID,
-			&category.Name,
-			&category.Slug,
-			&category.Description,
-			&image,
-			&parentID,
-			&category.CreatedAt,
-			&category.UpdatedAt,
-		)
-
+		var level int
+		var path string
+		category, err := scanCategory(rows, &level, &path)
 		if err != nil {
 			logrus.Error(err)
 			return nil, err
 		}
+		category.Level = level
+		category.Path = path
+		categories = append(categories, category)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
 
-		// Handle image
-		if image.Valid {
-			category.Image = image.String
-		}
-
-		// Handle parent_id
-		if parentID.Valid {
-			parentUUID, err := uuid.Parse(parentID.String)
-			if err == nil {
-				category.ParentID = &parentUUID
-			}
-		}
-
-		allCategories = append(allCategories, category)
+	if rootSlug != "" && len(categories) == 0 {
+		return nil, domain.ErrNotFound
 	}
 
-	// Build the tree structure
-	return m.buildCategoryTree(allCategories), nil
+	return buildCategoryTree(categories), nil
 }
 
-// buildCategoryTree builds a hierarchical tree from flat category list
-func (m *CategoryRepository) buildCategoryTree(categories []domain.Category) []domain.Category {
-	// Create a map for quick lookup
-	categoryMap := make(map[uuid.UUID]*domain.Category)
-	var rootCategories []*domain.Category
-
-	// First pass: create map and identify root categories
-	for i := range categories {
-		category := &categories[i]
-		categoryMap[category.ID] = category
+// buildCategoryTree nests the categories under their parent at any depth, keeping their order.
+// Categories whose parent isn't in the list are the roots of the tree.
+func buildCategoryTree(categories []domain.Category) []domain.Category {
+	inList := make(map[uuid.UUID]bool, len(categories))
+	for _, category := range categories {
+		inList[category.ID] = true
+	}
 
-		if category.ParentID == nil {
-			rootCategories = append(rootCategories, category)
+	children := make(map[uuid.UUID][]domain.Category)
+	var roots []domain.Category
+	for _, category := range categories {
+		if category.ParentID == nil || !inList[*category.ParentID] {
+			roots = append(roots, category)
+			continue
 		}
+		children[*category.ParentID] = append(children[*category.ParentID], category)
 	}
 
-	// Second pass: build parent-child relationships
-	for i := range categories {
-		category := &categories[i]
-		if category.ParentID != nil {
-			if parent, exists := categoryMap[*category.ParentID]; exists {
-				parent.Children = append(parent.Children, *category)
-			}
+	// Children are attached once their own subtree is complete, so no level gets lost in a copy
+	var attach func(category domain.Category) domain.Category
+	attach = func(category domain.Category) domain.Category {
+		for _, child := range children[category.ID] {
+			category.Children = append(category.Children, attach(child))
 		}
+		return category
 	}
 
-	// Convert pointers back to values for return
-	var result []domain.Category
-	for _, root := range rootCategories {
-		result = append(result, *root)
+	tree := make([]domain.Category, 0, len(roots))
+	for _, root := range roots {
+		tree = append(tree, attach(root))
 	}
-
-	return result
+	return tree
 }
 
 // Helper function to join strings
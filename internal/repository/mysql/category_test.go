@@ -0,0 +1,263 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+func TestBuildCategoryTree(t *testing.T) {
+	uae := domain.Category{ID: uuid.New(), Slug: "uae", Level: 1, Path: "uae"}
+	dubai := domain.Category{ID: uuid.New(), ParentID: &uae.ID, Slug: "dubai", Level: 2, Path: "uae/dubai"}
+	marina := domain.Category{ID: uuid.New(), ParentID: &dubai.ID, Slug: "marina", Level: 3, Path: "uae/dubai/marina"}
+	hotels := domain.Category{ID: uuid.New(), ParentID: &marina.ID, Slug: "hotels", Level: 4, Path: "uae/dubai/marina/hotels"}
+	travel := domain.Category{ID: uuid.New(), Slug: "travel", Level: 1, Path: "travel"}
+
+	tree := buildCategoryTree([]domain.Category{uae, travel, dubai, marina, hotels})
+
+	require.Len(t, tree, 2)
+	assert.Equal(t, "uae", tree[0].Slug)
+	assert.Equal(t, "travel", tree[1].Slug)
+	require.Len(t, tree[0].Children, 1)
+	require.Len(t, tree[0].Children[0].Children, 1)
+	require.Len(t, tree[0].Children[0].Children[0].Children, 1)
+	assert.Equal(t, "uae/dubai/marina/hotels", tree[0].Children[0].Children[0].Children[0].Path)
+}
+
+func TestBuildCategorySubtree(t *testing.T) {
+	uaeID := uuid.New()
+	dubai := domain.Category{ID: uuid.New(), ParentID: &uaeID, Slug: "dubai", Level: 2, Path: "uae/dubai"}
+	marina := domain.Category{ID: uuid.New(), ParentID: &dubai.ID, Slug: "marina", Level: 3, Path: "uae/dubai/marina"}
+
+	tree := buildCategoryTree([]domain.Category{dubai, marina})
+
+	require.Len(t, tree, 1)
+	assert.Equal(t, "dubai", tree[0].Slug)
+	require.Len(t, tree[0].Children, 1)
+	assert.Equal(t, "marina", tree[0].Children[0].Slug)
+}
+
+func TestFillChainPaths(t *testing.T) {
+	chain := []domain.Category{{Slug: "uae"}, {Slug: "dubai"}, {Slug: "hotels"}}
+
+	fillChainPaths(chain)
+
+	assert.Equal(t, 1, chain[0].Level)
+	assert.Equal(t, "uae", chain[0].Path)
+	assert.Equal(t, 3, chain[2].Level)
+	assert.Equal(t, "uae/dubai/hotels", chain[2].Path)
+}
+
+var categoryTestColumns = []string{"id", "name", "slug", "description", "image", "parent_id", "created_at", "updated_at", "deleted_at"}
+
+func TestGetAncestorsStopsAtCycles(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	now := time.Now()
+
+	// dubai and uae point at each other, the guarded walk stops once it is back at dubai
+	dubai, uae := uuid.New(), uuid.New()
+	mock.ExpectQuery(`FIND_IN_SET\(c\.id, chain\.visited\) = 0 AND chain\.distance < 100`).
+		WithArgs(dubai.String()).
+		WillReturnRows(sqlmock.NewRows(append(categoryTestColumns, "origin")).
+			AddRow(uae.String(), "UAE", "uae", "", nil, dubai.String(), now, now, nil, dubai.String()).
+			AddRow(dubai.String(), "Dubai", "dubai", "", nil, uae.String(), now, now, nil, dubai.String()))
+
+	res, err := NewCategoryRepository(db).GetAncestors(context.Background(), []uuid.UUID{dubai})
+
+	require.NoError(t, err)
+	require.Len(t, res[dubai], 2)
+	assert.Equal(t, "uae/dubai", res[dubai][1].Path)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func expectCategoryLock(mock sqlmock.Sqlmock, id uuid.UUID) {
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM category WHERE id = \? AND deleted_at IS NULL FOR UPDATE`).
+		WithArgs(id.String()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id.String()))
+}
+
+func expectAncestor(mock sqlmock.Sqlmock, id uuid.UUID, parentID *uuid.UUID, deletedAt *time.Time) {
+	var parent interface{}
+	if parentID != nil {
+		parent = parentID.String()
+	}
+	var deleted interface{}
+	if deletedAt != nil {
+		deleted = *deletedAt
+	}
+	mock.ExpectQuery(`SELECT parent_id, deleted_at FROM category WHERE id = \? FOR SHARE`).
+		WithArgs(id.String()).
+		WillReturnRows(sqlmock.NewRows([]string{"parent_id", "deleted_at"}).AddRow(parent, deleted))
+}
+
+func TestMoveCategory(t *testing.T) {
+	id, child, grandParent := uuid.New(), uuid.New(), uuid.New()
+	parent := uuid.New()
+	trashedAt := time.Now()
+
+	tests := []struct {
+		name     string
+		parentID *uuid.UUID
+		expect   func(mock sqlmock.Sqlmock)
+		wantErr  error
+	}{
+		{
+			name:     "under itself",
+			parentID: &id,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectCategoryLock(mock, id)
+				mock.ExpectRollback()
+			},
+			wantErr: domain.ErrCategoryCycle,
+		},
+		{
+			name:     "under a descendant",
+			parentID: &child,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectCategoryLock(mock, id)
+				expectAncestor(mock, child, &id, nil)
+				mock.ExpectRollback()
+			},
+			wantErr: domain.ErrCategoryCycle,
+		},
+		{
+			name:     "under a trashed parent",
+			parentID: &parent,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectCategoryLock(mock, id)
+				expectAncestor(mock, parent, nil, &trashedAt)
+				mock.ExpectRollback()
+			},
+			wantErr: domain.ErrBadParamInput,
+		},
+		{
+			name:     "under another branch",
+			parentID: &parent,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectCategoryLock(mock, id)
+				expectAncestor(mock, parent, &grandParent, nil)
+				expectAncestor(mock, grandParent, nil, nil)
+				mock.ExpectExec(`UPDATE category SET parent_id = \?, updated_at = \? WHERE id = \?`).
+					WithArgs(parent.String(), sqlmock.AnyArg(), id.String()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "to the root",
+			expect: func(mock sqlmock.Sqlmock) {
+				expectCategoryLock(mock, id)
+				mock.ExpectExec(`UPDATE category SET parent_id = \?, updated_at = \? WHERE id = \?`).
+					WithArgs(nil, sqlmock.AnyArg(), id.String()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+			tt.expect(mock)
+
+			err = NewCategoryRepository(db).Move(context.Background(), id, tt.parentID)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func expectParentLock(mock sqlmock.Sqlmock, id uuid.UUID, parentID *uuid.UUID) {
+	var parent interface{}
+	if parentID != nil {
+		parent = parentID.String()
+	}
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT parent_id FROM category WHERE id = \? AND deleted_at IS NULL FOR UPDATE`).
+		WithArgs(id.String()).
+		WillReturnRows(sqlmock.NewRows([]string{"parent_id"}).AddRow(parent))
+}
+
+func TestDeleteCategoryRestrictRefusesNonEmpty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	id := uuid.New()
+
+	expectParentLock(mock, id, nil)
+	mock.ExpectQuery(`SELECT\s+\(SELECT COUNT\(\*\) FROM category WHERE parent_id = \? AND deleted_at IS NULL\),\s+\(SELECT COUNT\(\*\) FROM article_category WHERE category_id = \?\)`).
+		WithArgs(id.String(), id.String()).
+		WillReturnRows(sqlmock.NewRows([]string{"children", "articles"}).AddRow(0, 3))
+	mock.ExpectRollback()
+
+	err = NewCategoryRepository(db).Delete(context.Background(), id, domain.CategoryDeletion{Strategy: domain.CategoryDeleteRestrict})
+
+	assert.Equal(t, domain.ErrCategoryNotEmpty, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteRootCategoryToParentUsesFallback(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	id, fallback := uuid.New(), uuid.New()
+
+	expectParentLock(mock, id, nil)
+	mock.ExpectExec(`UPDATE category SET parent_id = \?, updated_at = \? WHERE parent_id = \?`).
+		WithArgs(nil, sqlmock.AnyArg(), id.String()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	// Articles already linked to the fallback category are skipped rather than linked twice
+	mock.ExpectExec(`INSERT INTO article_category .* WHERE ac.category_id = \? AND NOT EXISTS \(\s+SELECT 1 FROM article_category existing\s+WHERE existing.article_id = ac.article_id AND existing.category_id = \?`).
+		WithArgs(fallback.String(), sqlmock.AnyArg(), id.String(), fallback.String()).
+		WillReturnResult(sqlmock.NewResult(0, 4))
+	mock.ExpectExec(`DELETE FROM article_category WHERE category_id = \?`).
+		WithArgs(id.String()).
+		WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectExec(`UPDATE article SET primary_category_id = \? WHERE primary_category_id = \?`).
+		WithArgs(fallback.String(), id.String()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE category SET deleted_at = \? WHERE id = \?`).
+		WithArgs(sqlmock.AnyArg(), id.String()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = NewCategoryRepository(db).Delete(context.Background(), id, domain.CategoryDeletion{
+		Strategy:   domain.CategoryDeleteToParent,
+		FallbackID: fallback,
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteCategoryMoveOntoDescendant(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	id, child := uuid.New(), uuid.New()
+
+	expectParentLock(mock, id, nil)
+	expectAncestor(mock, child, &id, nil)
+	mock.ExpectRollback()
+
+	err = NewCategoryRepository(db).Delete(context.Background(), id, domain.CategoryDeletion{
+		Strategy: domain.CategoryDeleteMoveTo,
+		TargetID: &child,
+	})
+
+	assert.Equal(t, domain.ErrCategoryCycle, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,195 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+const commentColumns = `id, article_id, parent_id, author_name, content, status, created_at, updated_at`
+
+type CommentRepository struct {
+	Conn *sql.DB
+}
+
+// NewCommentRepository will create an object that represent the comment.CommentRepository interface
+func NewCommentRepository(conn *sql.DB) *CommentRepository {
+	return &CommentRepository{conn}
+}
+
+func scanComment(row rowScanner) (domain.Comment, error) {
+	c := domain.Comment{}
+	var parentID sql.NullString
+	err := row.Scan(
+		&c.ID,
+		&c.ArticleID,
+		&parentID,
+		&c.AuthorName,
+		&c.Content,
+		&c.Status,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+	)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+
+	if parentID.Valid {
+		parentUUID, err := uuid.Parse(parentID.String)
+		if err == nil {
+			c.ParentID = &parentUUID
+		}
+	}
+	return c, nil
+}
+
+func (m *CommentRepository) fetch(ctx context.Context, query string, args ...interface{}) ([]domain.Comment, error) {
+	rows, err := m.Conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer func() {
+		errRow := rows.Close()
+		if errRow != nil {
+			logrus.Error(errRow)
+		}
+	}()
+
+	var comments []domain.Comment
+	for rows.Next() {
+		c, err := scanComment(rows)
+		if err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+		comments = append(comments, c)
+	}
+
+	return comments, rows.Err()
+}
+
+// FetchByArticle retrieves the comments of an article oldest first, only those with the given status unless it is empty
+func (m *CommentRepository) FetchByArticle(ctx context.Context, articleID uuid.UUID, status domain.CommentStatus) ([]domain.Comment, error) {
+	query := `SELECT ` + commentColumns + ` FROM comment WHERE article_id = ?`
+	args := []interface{}{articleID}
+	if status != "" {
+		query += ` AND status = ?`
+		args = append(args, status)
+	}
+	query += ` ORDER BY created_at, id`
+
+	return m.fetch(ctx, query, args...)
+}
+
+// FetchByStatus retrieves comments of every article with the given status, oldest first, for the moderation queue
+func (m *CommentRepository) FetchByStatus(ctx context.Context, status domain.CommentStatus, page, limit int) ([]domain.Comment, error) {
+	offset := (page - 1) * limit
+	query := `SELECT ` + commentColumns + ` FROM comment WHERE status = ? ORDER BY created_at, id LIMIT ? OFFSET ?`
+
+	return m.fetch(ctx, query, status, limit, offset)
+}
+
+func (m *CommentRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.Comment, error) {
+	query := `SELECT ` + commentColumns + ` FROM comment WHERE id = ?`
+
+	c, err := scanComment(m.Conn.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Comment{}, domain.ErrNotFound
+		}
+		logrus.Error(err)
+		return domain.Comment{}, err
+	}
+	return c, nil
+}
+
+// Store saves a new comment and refreshes the comment count of its article in the same transaction
+func (m *CommentRepository) Store(ctx context.Context, c *domain.Comment) (err error) {
+	return m.withArticleRecount(ctx, c.ArticleID, func(tx *sql.Tx) error {
+		query := `INSERT comment SET id=?, article_id=?, parent_id=?, author_name=?, content=?, status=?, created_at=?, updated_at=?`
+		_, err := tx.ExecContext(ctx, query, c.ID, c.ArticleID, c.ParentID, c.AuthorName, c.Content, c.Status, c.CreatedAt, c.UpdatedAt)
+		return err
+	})
+}
+
+// UpdateStatus moves a comment through moderation and refreshes the comment count of its article
+func (m *CommentRepository) UpdateStatus(ctx context.Context, c *domain.Comment) (err error) {
+	return m.withArticleRecount(ctx, c.ArticleID, func(tx *sql.Tx) error {
+		query := `UPDATE comment SET status=?, updated_at=? WHERE id=?`
+		res, err := tx.ExecContext(ctx, query, c.Status, c.UpdatedAt, c.ID)
+		if err != nil {
+			return err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return domain.ErrNotFound
+		}
+		return nil
+	})
+}
+
+// Delete removes a comment along with its replies and refreshes the comment count of its article
+func (m *CommentRepository) Delete(ctx context.Context, c *domain.Comment) (err error) {
+	return m.withArticleRecount(ctx, c.ArticleID, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, `DELETE FROM comment WHERE id = ?`, c.ID)
+		if err != nil {
+			return err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return domain.ErrNotFound
+		}
+		return nil
+	})
+}
+
+// withArticleRecount runs change in a transaction, then recounts the approved comments of the article.
+// The article row is locked first so concurrent changes on the same article recount one after the other.
+func (m *CommentRepository) withArticleRecount(ctx context.Context, articleID uuid.UUID, change func(tx *sql.Tx) error) (err error) {
+	tx, err := m.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	var id uuid.UUID
+	err = tx.QueryRowContext(ctx, `SELECT id FROM article WHERE id = ? FOR UPDATE`, articleID).Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.ErrNotFound
+		}
+		logrus.Error(err)
+		return err
+	}
+
+	if err = change(tx); err != nil {
+		return err
+	}
+
+	query := `UPDATE article SET comments = (
+				SELECT COUNT(*) FROM comment WHERE article_id = ? AND status = ?
+			  ) WHERE id = ?`
+	_, err = tx.ExecContext(ctx, query, articleID, domain.CommentApproved, articleID)
+	if err != nil {
+		logrus.Error(err)
+	}
+	return err
+}
@@ -0,0 +1,87 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+type LikeRepository struct {
+	Conn *sql.DB
+}
+
+// NewLikeRepository will create an object that represent the like.LikeRepository interface
+func NewLikeRepository(conn *sql.DB) *LikeRepository {
+	return &LikeRepository{conn}
+}
+
+// Like stores the like of userKey and bumps the article's counter in the same transaction,
+// an existing like changes nothing
+func (m *LikeRepository) Like(ctx context.Context, articleID uuid.UUID, userKey string) (int, error) {
+	return m.change(ctx, articleID, func(tx *sql.Tx) (int64, error) {
+		return execAffected(ctx, tx, `INSERT IGNORE INTO article_like (article_id, user_key, created_at) VALUES (?, ?, ?)`,
+			articleID, userKey, time.Now())
+	}, `UPDATE article SET likes = likes + 1 WHERE id = ?`)
+}
+
+// Unlike removes the like of userKey and lowers the article's counter in the same transaction,
+// a missing like changes nothing
+func (m *LikeRepository) Unlike(ctx context.Context, articleID uuid.UUID, userKey string) (int, error) {
+	return m.change(ctx, articleID, func(tx *sql.Tx) (int64, error) {
+		return execAffected(ctx, tx, `DELETE FROM article_like WHERE article_id = ? AND user_key = ?`, articleID, userKey)
+	}, `UPDATE article SET likes = GREATEST(likes - 1, 0) WHERE id = ?`)
+}
+
+// change runs the like change in a transaction holding the article row, adjusts the counter
+// with counterQuery when a row changed and returns the resulting count
+func (m *LikeRepository) change(ctx context.Context, articleID uuid.UUID, apply func(tx *sql.Tx) (int64, error), counterQuery string) (likes int, err error) {
+	tx, err := m.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	err = tx.QueryRowContext(ctx, `SELECT likes FROM article WHERE id = ? AND deleted_at IS NULL FOR UPDATE`, articleID).Scan(&likes)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, domain.ErrNotFound
+		}
+		logrus.Error(err)
+		return 0, err
+	}
+
+	affected, err := apply(tx)
+	if err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
+	if affected == 0 {
+		return likes, nil
+	}
+
+	if _, err = tx.ExecContext(ctx, counterQuery, articleID); err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
+	err = tx.QueryRowContext(ctx, `SELECT likes FROM article WHERE id = ?`, articleID).Scan(&likes)
+	return likes, err
+}
+
+func execAffected(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) (int64, error) {
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
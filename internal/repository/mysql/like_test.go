@@ -0,0 +1,94 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+func expectLikesLock(mock sqlmock.Sqlmock, articleID uuid.UUID, likes int) {
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT likes FROM article WHERE id = \? AND deleted_at IS NULL FOR UPDATE`).
+		WithArgs(articleID.String()).
+		WillReturnRows(sqlmock.NewRows([]string{"likes"}).AddRow(likes))
+}
+
+func TestLikeCountsOnce(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewLikeRepository(db)
+	articleID := uuid.New()
+
+	// The first like is stored and bumps the counter
+	expectLikesLock(mock, articleID, 4)
+	mock.ExpectExec(`INSERT IGNORE INTO article_like`).
+		WithArgs(articleID.String(), "user:1", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE article SET likes = likes \+ 1 WHERE id = \?`).
+		WithArgs(articleID.String()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT likes FROM article WHERE id = \?`).
+		WithArgs(articleID.String()).
+		WillReturnRows(sqlmock.NewRows([]string{"likes"}).AddRow(5))
+	mock.ExpectCommit()
+
+	// Liking again finds the like already there and leaves the counter alone
+	expectLikesLock(mock, articleID, 5)
+	mock.ExpectExec(`INSERT IGNORE INTO article_like`).
+		WithArgs(articleID.String(), "user:1", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	first, err := repo.Like(context.Background(), articleID, "user:1")
+	require.NoError(t, err)
+	second, err := repo.Like(context.Background(), articleID, "user:1")
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, first)
+	assert.Equal(t, 5, second)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUnlikeWithoutLikeChangesNothing(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	articleID := uuid.New()
+
+	expectLikesLock(mock, articleID, 5)
+	mock.ExpectExec(`DELETE FROM article_like WHERE article_id = \? AND user_key = \?`).
+		WithArgs(articleID.String(), "user:1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	likes, err := NewLikeRepository(db).Unlike(context.Background(), articleID, "user:1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, likes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLikeTrashedArticle(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	articleID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT likes FROM article WHERE id = \? AND deleted_at IS NULL FOR UPDATE`).
+		WithArgs(articleID.String()).
+		WillReturnRows(sqlmock.NewRows([]string{"likes"}))
+	mock.ExpectRollback()
+
+	_, err = NewLikeRepository(db).Like(context.Background(), articleID, "user:1")
+
+	assert.Equal(t, domain.ErrNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
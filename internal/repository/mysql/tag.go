@@ -0,0 +1,120 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+type TagRepository struct {
+	Conn *sql.DB
+}
+
+// NewTagRepository will create an object that represent the tag.TagRepository interface
+func NewTagRepository(conn *sql.DB) *TagRepository {
+	return &TagRepository{conn}
+}
+
+// Fetch retrieves every tag ordered by name
+func (m *TagRepository) Fetch(ctx context.Context) ([]domain.Tag, error) {
+	rows, err := m.Conn.QueryContext(ctx, `SELECT id, name, slug, created_at, updated_at FROM tag ORDER BY name`)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer func() {
+		errRow := rows.Close()
+		if errRow != nil {
+			logrus.Error(errRow)
+		}
+	}()
+
+	tags := make([]domain.Tag, 0)
+	for rows.Next() {
+		t := domain.Tag{}
+		if err = rows.Scan(&t.ID, &t.Name, &t.Slug, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+
+	return tags, rows.Err()
+}
+
+// GetBySlug retrieves a tag by its slug
+func (m *TagRepository) GetBySlug(ctx context.Context, slug string) (domain.Tag, error) {
+	query := `SELECT id, name, slug, created_at, updated_at FROM tag WHERE slug = ?`
+
+	t := domain.Tag{}
+	err := m.Conn.QueryRowContext(ctx, query, slug).Scan(&t.ID, &t.Name, &t.Slug, &t.CreatedAt, &t.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.Tag{}, domain.ErrNotFound
+		}
+		logrus.Error(err)
+		return domain.Tag{}, err
+	}
+	return t, nil
+}
+
+// Cloud retrieves the most used tags with the number of articles visible at the given time using them
+func (m *TagRepository) Cloud(ctx context.Context, visibleAt time.Time, limit int) ([]domain.TagCount, error) {
+	query := `SELECT t.id, t.name, t.slug, t.created_at, t.updated_at, COUNT(*) AS articles
+			  FROM tag t
+			  INNER JOIN article_tag at ON at.tag_id = t.id
+			  INNER JOIN article a ON a.id = at.article_id
+			  WHERE a.published = 1 AND a.published_at <= ? AND a.deleted_at IS NULL
+			  GROUP BY t.id, t.name, t.slug, t.created_at, t.updated_at
+			  ORDER BY articles DESC, t.name
+			  LIMIT ?`
+
+	rows, err := m.Conn.QueryContext(ctx, query, visibleAt, limit)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer func() {
+		errRow := rows.Close()
+		if errRow != nil {
+			logrus.Error(errRow)
+		}
+	}()
+
+	cloud := make([]domain.TagCount, 0)
+	for rows.Next() {
+		t := domain.TagCount{}
+		if err = rows.Scan(&t.ID, &t.Name, &t.Slug, &t.CreatedAt, &t.UpdatedAt, &t.Articles); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+		cloud = append(cloud, t)
+	}
+
+	return cloud, rows.Err()
+}
+
+// Update renames a tag, which renames it on every article using it
+func (m *TagRepository) Update(ctx context.Context, t *domain.Tag) error {
+	query := `UPDATE tag SET name = ?, slug = ?, updated_at = ? WHERE id = ?`
+
+	res, err := m.Conn.ExecContext(ctx, query, t.Name, t.Slug, t.UpdatedAt, t.ID)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
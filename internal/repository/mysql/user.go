@@ -0,0 +1,60 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+type UserRepository struct {
+	DB *sql.DB
+}
+
+// NewUserRepository will create an object that represent the auth.UserRepository interface
+func NewUserRepository(db *sql.DB) *UserRepository {
+	return &UserRepository{
+		DB: db,
+	}
+}
+
+// GetByEmail retrieves a user by its email address
+func (m *UserRepository) GetByEmail(ctx context.Context, email string) (domain.User, error) {
+	query := `SELECT id, email, password_hash, role, author_id, created_at, updated_at
+			  FROM user
+			  WHERE email = ?`
+
+	row := m.DB.QueryRowContext(ctx, query, email)
+
+	user := domain.User{}
+	var authorID sql.NullString
+	err := row.Scan(
+		&user.ID,
+		&user.Email,
+		&user.PasswordHash,
+		&user.Role,
+		&authorID,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.User{}, domain.ErrNotFound
+		}
+		logrus.Error(err)
+		return domain.User{}, err
+	}
+
+	// Handle author_id
+	if authorID.Valid {
+		authorUUID, err := uuid.Parse(authorID.String)
+		if err == nil {
+			user.AuthorID = &authorUUID
+		}
+	}
+
+	return user, nil
+}
@@ -2,6 +2,7 @@ package rest
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
@@ -23,13 +24,20 @@ type ResponseError struct {
 //
 //go:generate mockery --name ArticleService
 type ArticleService interface {
-	Fetch(ctx context.Context, page, limit int) ([]domain.ArticleResponse, error)
+	Fetch(ctx context.Context, filter domain.ArticleFilter, page, limit int) ([]domain.ArticleResponse, int, error)
+	FetchByCursor(ctx context.Context, filter domain.ArticleFilter, cursor string, limit int) ([]domain.ArticleResponse, domain.PageInfo, error)
+	FetchByAuthorSlug(ctx context.Context, slug string, page, limit int) ([]domain.ArticleResponse, int, error)
+	Search(ctx context.Context, query string, page, limit int) ([]domain.ArticleSearchResult, error)
 	GetByID(ctx context.Context, id uuid.UUID) (domain.ArticleResponse, error)
 	Update(ctx context.Context, ar *domain.Article) error
-	UpdatePartial(ctx context.Context, id uuid.UUID, updates map[string]interface{}) error
+	UpdatePartial(ctx context.Context, id uuid.UUID, version int, updates map[string]interface{}) error
 	GetBySlug(ctx context.Context, slug string) (domain.ArticleResponse, error)
 	Store(context.Context, *domain.Article) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID, version int) error
+	FetchRevisions(ctx context.Context, id uuid.UUID) ([]domain.ArticleRevision, error)
+	GetRevision(ctx context.Context, id uuid.UUID, revision int) (domain.ArticleRevision, error)
+	DiffRevisions(ctx context.Context, id uuid.UUID, from, to int) (domain.RevisionDiff, error)
+	RestoreRevision(ctx context.Context, id uuid.UUID, revision int) error
 }
 
 // ArticleHandler  represent the httphandler for article
@@ -46,11 +54,18 @@ func NewArticleHandler(e *echo.Echo, svc ArticleService) {
 		Service: svc,
 	}
 	e.GET("/articles", handler.FetchArticle)
+	e.GET("/articles/search", handler.Search)
 	e.POST("/articles", handler.Store)
 	e.PATCH("/articles/:id", handler.Update)
 	e.GET("/articles/:id", handler.GetByID)
 	e.GET("/articles/slug/:slug", handler.GetBySlug)
 	e.DELETE("/articles/:id", handler.Delete)
+	e.GET("/authors/slug/:slug/articles", handler.FetchByAuthor)
+	e.GET("/tags/:slug/articles", handler.FetchByTag)
+	e.GET("/articles/:id/revisions", handler.FetchRevisions)
+	e.GET("/articles/:id/revisions/diff", handler.DiffRevisions)
+	e.GET("/articles/:id/revisions/:revision", handler.GetRevision)
+	e.POST("/articles/:id/revisions/:revision/restore", handler.RestoreRevision)
 }
 
 // FetchArticle will fetch the article based on given params
@@ -69,14 +84,189 @@ func (a *ArticleHandler) FetchArticle(c echo.Context) error {
 		limit = defaultLimit
 	}
 
-	ctx := c.Request().Context()
+	filter, err := parseArticleFilter(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	ctx := withPreview(c)
 
-	listAr, err := a.Service.Fetch(ctx, page, limit)
+	// Clients opt into keyset pagination by sending a cursor, empty for the first page
+	if c.QueryParams().Has("cursor") {
+		listAr, pageInfo, err := a.Service.FetchByCursor(ctx, filter, c.QueryParam("cursor"), limit)
+		if err != nil {
+			return c.JSON(getStatusCode(err), getErrorResponse(err))
+		}
+		setCursorLinks(c, pageInfo)
+		v := listValidators(listAr, articleResponseKey, pageInfo.NextCursor, pageInfo.PrevCursor, strconv.Itoa(limit))
+		return conditionalJSON(c, v, CursorResponse{Data: listAr, PageInfo: pageInfo, Limit: limit})
+	}
+
+	listAr, total, err := a.Service.Fetch(ctx, filter, page, limit)
 	if err != nil {
 		return c.JSON(getStatusCode(err), getErrorResponse(err))
 	}
 
-	return c.JSON(http.StatusOK, listAr)
+	res := newPageResponse(listAr, total, page, limit)
+	setPageLinks(c, res)
+	return conditionalJSON(c, listValidators(listAr, articleResponseKey, fmt.Sprint(total, page, limit)), res)
+}
+
+// parseArticleFilter reads the article list filters from the query params:
+// category, include_descendants, tag, author_id, published, published_from and published_to
+func parseArticleFilter(c echo.Context) (filter domain.ArticleFilter, err error) {
+	filter.CategorySlug = c.QueryParam("category")
+	filter.Tag = c.QueryParam("tag")
+
+	if s := c.QueryParam("include_descendants"); s != "" {
+		filter.IncludeDescendants, err = strconv.ParseBool(s)
+		if err != nil {
+			return filter, fmt.Errorf("invalid include_descendants value %q", s)
+		}
+	}
+
+	if s := c.QueryParam("author_id"); s != "" {
+		authorID, err := uuid.Parse(s)
+		if err != nil {
+			return filter, fmt.Errorf("invalid author_id value %q", s)
+		}
+		filter.AuthorID = &authorID
+	}
+
+	if s := c.QueryParam("published"); s != "" {
+		published, err := strconv.ParseBool(s)
+		if err != nil {
+			return filter, fmt.Errorf("invalid published value %q", s)
+		}
+		filter.Published = &published
+	}
+
+	if s := c.QueryParam("published_from"); s != "" {
+		from, _, err := parseDateParam(s)
+		if err != nil {
+			return filter, fmt.Errorf("invalid published_from value %q", s)
+		}
+		filter.PublishedFrom = &from
+	}
+
+	if s := c.QueryParam("published_to"); s != "" {
+		to, dateOnly, err := parseDateParam(s)
+		if err != nil {
+			return filter, fmt.Errorf("invalid published_to value %q", s)
+		}
+		if dateOnly {
+			// A plain date includes the whole day
+			to = to.Add(24*time.Hour - time.Nanosecond)
+		}
+		filter.PublishedTo = &to
+	}
+
+	return filter, nil
+}
+
+// parseDateParam accepts either an RFC3339 timestamp or a plain YYYY-MM-DD date
+func parseDateParam(s string) (t time.Time, dateOnly bool, err error) {
+	if t, err = time.Parse(time.RFC3339, s); err == nil {
+		return t, false, nil
+	}
+	t, err = time.Parse("2006-01-02", s)
+	return t, true, err
+}
+
+// Search will run a full-text search over the articles with the q param
+func (a *ArticleHandler) Search(c echo.Context) error {
+	q := c.QueryParam("q")
+	if q == "" {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Query param q is required"})
+	}
+
+	// Parse page parameter
+	pageS := c.QueryParam("page")
+	page, err := strconv.Atoi(pageS)
+	if err != nil || page < 1 {
+		page = defaultPage
+	}
+
+	// Parse limit parameter
+	limitS := c.QueryParam("limit")
+	limit, err := strconv.Atoi(limitS)
+	if err != nil || limit < 1 {
+		limit = defaultLimit
+	}
+
+	ctx := withPreview(c)
+
+	results, err := a.Service.Search(ctx, q, page, limit)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	v := listValidators(results, func(r domain.ArticleSearchResult) (string, time.Time) {
+		return articleResponseKey(r.ArticleResponse)
+	})
+	return conditionalJSON(c, v, results)
+}
+
+// FetchByAuthor will fetch the articles of the author with the given slug
+func (a *ArticleHandler) FetchByAuthor(c echo.Context) error {
+	slug := c.Param("slug")
+
+	if slug == "" {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Slug is required"})
+	}
+
+	// Parse page parameter
+	pageS := c.QueryParam("page")
+	page, err := strconv.Atoi(pageS)
+	if err != nil || page < 1 {
+		page = defaultPage
+	}
+
+	// Parse limit parameter
+	limitS := c.QueryParam("limit")
+	limit, err := strconv.Atoi(limitS)
+	if err != nil || limit < 1 {
+		limit = defaultLimit
+	}
+
+	ctx := withPreview(c)
+
+	listAr, total, err := a.Service.FetchByAuthorSlug(ctx, slug, page, limit)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	res := newPageResponse(listAr, total, page, limit)
+	setPageLinks(c, res)
+	return conditionalJSON(c, listValidators(listAr, articleResponseKey, fmt.Sprint(total, page, limit)), res)
+}
+
+// FetchByTag will fetch the articles carrying the tag, for tag pages
+func (a *ArticleHandler) FetchByTag(c echo.Context) error {
+	// Parse page parameter
+	pageS := c.QueryParam("page")
+	page, err := strconv.Atoi(pageS)
+	if err != nil || page < 1 {
+		page = defaultPage
+	}
+
+	// Parse limit parameter
+	limitS := c.QueryParam("limit")
+	limit, err := strconv.Atoi(limitS)
+	if err != nil || limit < 1 {
+		limit = defaultLimit
+	}
+
+	ctx := withPreview(c)
+
+	listAr, total, err := a.Service.Fetch(ctx, domain.ArticleFilter{Tag: c.Param("slug")}, page, limit)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	res := newPageResponse(listAr, total, page, limit)
+	setPageLinks(c, res)
+	return conditionalJSON(c, listValidators(listAr, articleResponseKey, fmt.Sprint(total, page, limit)), res)
 }
 
 // GetByID will get article by given id
@@ -87,14 +277,14 @@ func (a *ArticleHandler) GetByID(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
 	}
 
-	ctx := c.Request().Context()
+	ctx := withPreview(c)
 
 	art, err := a.Service.GetByID(ctx, id)
 	if err != nil {
 		return c.JSON(getStatusCode(err), getErrorResponse(err))
 	}
 
-	return c.JSON(http.StatusOK, art)
+	return conditionalJSON(c, articleValidators(art), art)
 }
 
 // GetByID will get article by given id
@@ -105,14 +295,24 @@ func (a *ArticleHandler) GetBySlug(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Slug is required"})
 	}
 
-	ctx := c.Request().Context()
+	ctx := withPreview(c)
 
 	art, err := a.Service.GetBySlug(ctx, slug)
 	if err != nil {
 		return c.JSON(getStatusCode(err), getErrorResponse(err))
 	}
 
-	return c.JSON(http.StatusOK, art)
+	return conditionalJSON(c, articleValidators(art), art)
+}
+
+// withPreview returns the request context, asking for the unpublished articles
+// the authenticated user may see when the preview query param is true
+func withPreview(c echo.Context) context.Context {
+	ctx := c.Request().Context()
+	if preview, _ := strconv.ParseBool(c.QueryParam("preview")); preview {
+		ctx = domain.NewContextWithPreview(ctx)
+	}
+	return ctx
 }
 
 func isRequestValid(m *domain.Article) (bool, error) {
@@ -154,7 +354,8 @@ func (a *ArticleHandler) Store(c echo.Context) (err error) {
 	return c.JSON(http.StatusCreated, article)
 }
 
-// Update will update the article by given request body (PATCH - partial update)
+// Update will update the article by given request body (PATCH - partial update).
+// The If-Match header must carry the ETag of the version the changes were made on.
 func (a *ArticleHandler) Update(c echo.Context) (err error) {
 	idStr := c.Param("id")
 	articleID, err := uuid.Parse(idStr)
@@ -162,6 +363,11 @@ func (a *ArticleHandler) Update(c echo.Context) (err error) {
 		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
 	}
 
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
 	// Parse partial update data
 	updateData := make(map[string]interface{})
 	err = c.Bind(&updateData)
@@ -201,15 +407,6 @@ func (a *ArticleHandler) Update(c echo.Context) (err error) {
 	if readingTime, ok := updateData["reading_time_minutes"].(float64); ok {
 		processedUpdates["reading_time_minutes"] = int(readingTime)
 	}
-	if views, ok := updateData["views"].(float64); ok {
-		processedUpdates["views"] = int(views)
-	}
-	if likes, ok := updateData["likes"].(float64); ok {
-		processedUpdates["likes"] = int(likes)
-	}
-	if comments, ok := updateData["comments"].(float64); ok {
-		processedUpdates["comments"] = int(comments)
-	}
 
 	// Handle boolean fields
 	if published, ok := updateData["published"].(bool); ok {
@@ -288,24 +485,33 @@ func (a *ArticleHandler) Update(c echo.Context) (err error) {
 			processedUpdates["categories"] = categories
 		}
 	}
+	if primaryCategoryID, ok := updateData["primary_category_id"].(string); ok {
+		parsedPrimaryCategoryID, err := uuid.Parse(primaryCategoryID)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid primary category UUID format"})
+		}
+		processedUpdates["primary_category_id"] = parsedPrimaryCategoryID
+	}
 
 	// Use the new UpdatePartial method
 	ctx := c.Request().Context()
-	err = a.Service.UpdatePartial(ctx, articleID, processedUpdates)
+	err = a.Service.UpdatePartial(ctx, articleID, version, processedUpdates)
 	if err != nil {
 		return c.JSON(getStatusCode(err), getErrorResponse(err))
 	}
 
-	// Return the updated article
-	updatedArticle, err := a.Service.GetByID(ctx, articleID)
+	// Return the updated article, even when it is not published yet
+	updatedArticle, err := a.Service.GetByID(domain.NewContextWithPreview(ctx), articleID)
 	if err != nil {
 		return c.JSON(getStatusCode(err), getErrorResponse(err))
 	}
 
+	// Same validators as a read of the article, so If-Match and If-None-Match see one format
+	setValidators(c, articleValidators(updatedArticle))
 	return c.JSON(http.StatusOK, updatedArticle.Article)
 }
 
-// Delete will delete article by given param
+// Delete will delete article by given param, the If-Match header must carry the ETag of its current version
 func (a *ArticleHandler) Delete(c echo.Context) error {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
@@ -313,9 +519,14 @@ func (a *ArticleHandler) Delete(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
 	}
 
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
 	ctx := c.Request().Context()
 
-	err = a.Service.Delete(ctx, id)
+	err = a.Service.Delete(ctx, id, version)
 	if err != nil {
 		return c.JSON(getStatusCode(err), getErrorResponse(err))
 	}
@@ -336,6 +547,20 @@ func getStatusCode(err error) int {
 		return http.StatusNotFound
 	case domain.ErrConflict:
 		return http.StatusConflict
+	case domain.ErrBadParamInput:
+		return http.StatusBadRequest
+	case domain.ErrAuthorHasArticles:
+		return http.StatusConflict
+	case domain.ErrUnauthorized:
+		return http.StatusUnauthorized
+	case domain.ErrForbidden:
+		return http.StatusForbidden
+	case domain.ErrPreconditionRequired:
+		return http.StatusPreconditionRequired
+	case domain.ErrPreconditionFailed:
+		return http.StatusPreconditionFailed
+	case domain.ErrCategoryCycle, domain.ErrCategoryNotEmpty, domain.ErrDefaultCategory:
+		return http.StatusConflict
 	default:
 		return http.StatusInternalServerError
 	}
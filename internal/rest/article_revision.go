@@ -0,0 +1,93 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// FetchRevisions will fetch the revision history of the article
+func (a *ArticleHandler) FetchRevisions(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
+	}
+
+	revisions, err := a.Service.FetchRevisions(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, revisions)
+}
+
+// GetRevision will get one revision of the article by its number
+func (a *ArticleHandler) GetRevision(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
+	}
+	revision, err := strconv.Atoi(c.Param("revision"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid revision number"})
+	}
+
+	rev, err := a.Service.GetRevision(c.Request().Context(), id, revision)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, rev)
+}
+
+// DiffRevisions will compare the revisions given by ?from= and ?to= field by field
+func (a *ArticleHandler) DiffRevisions(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
+	}
+	from, err := strconv.Atoi(c.QueryParam("from"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid from revision number"})
+	}
+	to, err := strconv.Atoi(c.QueryParam("to"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid to revision number"})
+	}
+
+	diff, err := a.Service.DiffRevisions(c.Request().Context(), id, from, to)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, diff)
+}
+
+// RestoreRevision will bring the content of the revision back into the article and return the article
+func (a *ArticleHandler) RestoreRevision(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
+	}
+	revision, err := strconv.Atoi(c.Param("revision"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid revision number"})
+	}
+
+	ctx := c.Request().Context()
+	err = a.Service.RestoreRevision(ctx, id, revision)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	restored, err := a.Service.GetByID(domain.NewContextWithPreview(ctx), id)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, restored.Article)
+}
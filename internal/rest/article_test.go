@@ -0,0 +1,63 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// singleArticle serves one article and bumps its version on partial updates,
+// any other call panics
+type singleArticle struct {
+	ArticleService
+	article domain.ArticleResponse
+}
+
+func (s *singleArticle) GetByID(context.Context, uuid.UUID) (domain.ArticleResponse, error) {
+	return s.article, nil
+}
+
+func (s *singleArticle) UpdatePartial(_ context.Context, _ uuid.UUID, version int, updates map[string]interface{}) error {
+	if version != s.article.Version {
+		return domain.ErrPreconditionFailed
+	}
+	s.article.Title = updates["title"].(string)
+	s.article.Version++
+	return nil
+}
+
+func TestUpdateSendsTheReadETag(t *testing.T) {
+	updatedAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	svc := &singleArticle{article: domain.ArticleResponse{
+		Article: domain.Article{ID: uuid.New(), Title: "Draft", Version: 2, UpdatedAt: updatedAt},
+	}}
+	e := echo.New()
+	NewArticleHandler(e, svc)
+	path := "/articles/" + svc.article.ID.String()
+
+	read := httptest.NewRecorder()
+	e.ServeHTTP(read, httptest.NewRequest(http.MethodGet, path, nil))
+	require.Equal(t, http.StatusOK, read.Code)
+
+	req := httptest.NewRequest(http.MethodPatch, path, strings.NewReader(`{"title":"Final"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(headerIfMatch, read.Header().Get(headerETag))
+	update := httptest.NewRecorder()
+	e.ServeHTTP(update, req)
+	require.Equal(t, http.StatusOK, update.Code)
+
+	reread := httptest.NewRecorder()
+	e.ServeHTTP(reread, httptest.NewRequest(http.MethodGet, path, nil))
+	assert.Equal(t, reread.Header().Get(headerETag), update.Header().Get(headerETag))
+	assert.True(t, strings.HasPrefix(update.Header().Get(headerETag), `W/"3-`))
+}
@@ -0,0 +1,68 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+// AuthService represent the authentication usecases
+//
+//go:generate mockery --name AuthService
+type AuthService interface {
+	Login(ctx context.Context, email, password string) (token string, expiresAt time.Time, err error)
+}
+
+// LoginRequest represent the credentials sent to the login endpoint
+type LoginRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+}
+
+// TokenResponse represent the access token issued on a successful login
+type TokenResponse struct {
+	AccessToken string    `json:"access_token"`
+	TokenType   string    `json:"token_type"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// AuthHandler represent the httphandler for authentication
+type AuthHandler struct {
+	Service AuthService
+}
+
+// NewAuthHandler will initialize the auth/ resources endpoint
+func NewAuthHandler(e *echo.Echo, svc AuthService) {
+	handler := &AuthHandler{
+		Service: svc,
+	}
+	e.POST("/auth/login", handler.Login)
+}
+
+// Login will exchange the given credentials for a signed access token
+func (a *AuthHandler) Login(c echo.Context) (err error) {
+	var req LoginRequest
+	err = c.Bind(&req)
+	if err != nil {
+		return c.JSON(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	if err = validator.New().Struct(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	token, expiresAt, err := a.Service.Login(ctx, req.Email, req.Password)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, TokenResponse{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresAt:   expiresAt,
+	})
+}
@@ -0,0 +1,228 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	validator "gopkg.in/go-playground/validator.v9"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// AuthorService represent the author's usecases
+//
+//go:generate mockery --name AuthorService
+type AuthorService interface {
+	Fetch(ctx context.Context, page, limit int) ([]domain.Author, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Author, error)
+	GetBySlug(ctx context.Context, slug string) (domain.Author, error)
+	Store(ctx context.Context, a *domain.Author) error
+	Update(ctx context.Context, a *domain.Author) error
+	Delete(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) error
+}
+
+// AuthorHandler represent the httphandler for author
+type AuthorHandler struct {
+	Service AuthorService
+}
+
+// NewAuthorHandler will initialize the authors/ resources endpoint
+func NewAuthorHandler(e *echo.Echo, svc AuthorService) {
+	handler := &AuthorHandler{
+		Service: svc,
+	}
+	e.GET("/authors", handler.FetchAuthor)
+	e.POST("/authors", handler.Store)
+	e.GET("/authors/:id", handler.GetByID)
+	e.GET("/authors/slug/:slug", handler.GetBySlug)
+	e.PATCH("/authors/:id", handler.Update)
+	e.DELETE("/authors/:id", handler.Delete)
+}
+
+// FetchAuthor will fetch the authors based on given params
+func (a *AuthorHandler) FetchAuthor(c echo.Context) error {
+	// Parse page parameter
+	pageS := c.QueryParam("page")
+	page, err := strconv.Atoi(pageS)
+	if err != nil || page < 1 {
+		page = defaultPage
+	}
+
+	// Parse limit parameter
+	limitS := c.QueryParam("limit")
+	limit, err := strconv.Atoi(limitS)
+	if err != nil || limit < 1 {
+		limit = defaultLimit
+	}
+
+	ctx := c.Request().Context()
+
+	authors, err := a.Service.Fetch(ctx, page, limit)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return conditionalJSON(c, listValidators(authors, authorKey, strconv.Itoa(page), strconv.Itoa(limit)), authors)
+}
+
+// GetByID will get author by given id
+func (a *AuthorHandler) GetByID(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
+	}
+
+	ctx := c.Request().Context()
+
+	author, err := a.Service.GetByID(ctx, id)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return conditionalJSON(c, itemValidators(author, authorKey), author)
+}
+
+// GetBySlug will get author profile by given slug
+func (a *AuthorHandler) GetBySlug(c echo.Context) error {
+	slug := c.Param("slug")
+
+	if slug == "" {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Slug is required"})
+	}
+
+	ctx := c.Request().Context()
+
+	author, err := a.Service.GetBySlug(ctx, slug)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return conditionalJSON(c, itemValidators(author, authorKey), author)
+}
+
+func isAuthorRequestValid(m *domain.Author) (bool, error) {
+	validate := validator.New()
+	err := validate.Struct(m)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Store will store the author by given request body
+func (a *AuthorHandler) Store(c echo.Context) (err error) {
+	var author domain.Author
+	err = c.Bind(&author)
+	if err != nil {
+		return c.JSON(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	var ok bool
+	if ok, err = isAuthorRequestValid(&author); !ok {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	err = a.Service.Store(ctx, &author)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusCreated, author)
+}
+
+// Update will update the author by given request body (PATCH - partial update)
+func (a *AuthorHandler) Update(c echo.Context) (err error) {
+	idStr := c.Param("id")
+	authorID, err := uuid.Parse(idStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
+	}
+
+	// Get existing author
+	ctx := c.Request().Context()
+	existingAuthor, err := a.Service.GetByID(ctx, authorID)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	// Parse partial update data
+	updateData := make(map[string]interface{})
+	err = c.Bind(&updateData)
+	if err != nil {
+		return c.JSON(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	// Apply partial updates to existing author
+	updatedAuthor := existingAuthor
+	if name, ok := updateData["name"].(string); ok {
+		updatedAuthor.Name = name
+	}
+	if slug, ok := updateData["slug"].(string); ok {
+		updatedAuthor.Slug = slug
+	}
+	if bio, ok := updateData["bio"].(string); ok {
+		updatedAuthor.Bio = bio
+	}
+	if avatar, ok := updateData["avatar"].(string); ok {
+		updatedAuthor.Avatar = avatar
+	}
+
+	// Handle social links (JSONStringMap)
+	if linksData, ok := updateData["social_links"]; ok {
+		if linksMap, ok := linksData.(map[string]interface{}); ok {
+			links := domain.JSONStringMap{}
+			for network, link := range linksMap {
+				if linkStr, ok := link.(string); ok {
+					links[network] = linkStr
+				}
+			}
+			updatedAuthor.SocialLinks = links
+		}
+	}
+
+	// Validate updated author
+	var ok bool
+	if ok, err = isAuthorRequestValid(&updatedAuthor); !ok {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	err = a.Service.Update(ctx, &updatedAuthor)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, updatedAuthor)
+}
+
+// Delete will delete author by given param. Authors that still own articles
+// can only be deleted when reassign_to names the author taking them over.
+func (a *AuthorHandler) Delete(c echo.Context) error {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
+	}
+
+	var reassignTo *uuid.UUID
+	if reassignS := c.QueryParam("reassign_to"); reassignS != "" {
+		parsedID, err := uuid.Parse(reassignS)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid reassign_to UUID format"})
+		}
+		reassignTo = &parsedID
+	}
+
+	ctx := c.Request().Context()
+
+	err = a.Service.Delete(ctx, id, reassignTo)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
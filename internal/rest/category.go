@@ -2,6 +2,9 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 
@@ -12,15 +15,17 @@ import (
 )
 
 type CategoryService interface {
-	Fetch(ctx context.Context, page, limit int) ([]domain.Category, error)
+	Fetch(ctx context.Context, page, limit int) ([]domain.Category, int, error)
+	FetchByCursor(ctx context.Context, cursor string, limit int) ([]domain.Category, domain.PageInfo, error)
 	GetBySlug(ctx context.Context, slug string) (domain.Category, error)
 	GetByID(ctx context.Context, id uuid.UUID) (domain.Category, error)
 	Update(ctx context.Context, cat *domain.Category) error
+	Move(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) (domain.Category, error)
 	Store(context.Context, *domain.Category) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID, strategy domain.CategoryDeleteStrategy, targetID *uuid.UUID) error
 	GetChildren(ctx context.Context, parentID uuid.UUID) ([]domain.Category, error)
 	GetRootCategories(ctx context.Context) ([]domain.Category, error)
-	GetCategoryTree(ctx context.Context) ([]domain.Category, error)
+	GetCategoryTree(ctx context.Context, rootSlug string, depth int) ([]domain.Category, error)
 	GetCategoryWithChildren(ctx context.Context, slug string) (domain.Category, error)
 }
 
@@ -41,6 +46,7 @@ func NewCategoryHandler(e *echo.Echo, svc CategoryService) {
 	e.GET("/categories/roots", handler.GetRootCategories)
 	e.POST("/categories", handler.Store)
 	e.PATCH("/categories/:id", handler.Update)
+	e.POST("/categories/:id/move", handler.Move)
 	e.GET("/categories/:slug", handler.GetBySlug)
 	e.GET("/categories/:id", handler.GetByID)
 	e.GET("/categories/:slug/children", handler.GetChildren)
@@ -64,12 +70,25 @@ func (cat *CategoryHandler) FetchCategory(c echo.Context) error {
 
 	ctx := c.Request().Context()
 
-	listCat, err := cat.Category.Fetch(ctx, page, limit)
+	// Clients opt into keyset pagination by sending a cursor, empty for the first page
+	if c.QueryParams().Has("cursor") {
+		listCat, pageInfo, err := cat.Category.FetchByCursor(ctx, c.QueryParam("cursor"), limit)
+		if err != nil {
+			return c.JSON(getStatusCode(err), getErrorResponse(err))
+		}
+		setCursorLinks(c, pageInfo)
+		v := listValidators(listCat, categoryKey, pageInfo.NextCursor, pageInfo.PrevCursor, strconv.Itoa(limit))
+		return conditionalJSON(c, v, CursorResponse{Data: listCat, PageInfo: pageInfo, Limit: limit})
+	}
+
+	listCat, total, err := cat.Category.Fetch(ctx, page, limit)
 	if err != nil {
 		return c.JSON(getStatusCode(err), getErrorResponse(err))
 	}
 
-	return c.JSON(http.StatusOK, listCat)
+	res := newPageResponse(listCat, total, page, limit)
+	setPageLinks(c, res)
+	return conditionalJSON(c, listValidators(listCat, categoryKey, fmt.Sprint(total, page, limit)), res)
 }
 
 // GetBySlug will get category by given slug
@@ -87,7 +106,7 @@ func (cat *CategoryHandler) GetBySlug(c echo.Context) error {
 		return c.JSON(getStatusCode(err), getErrorResponse(err))
 	}
 
-	return c.JSON(http.StatusOK, category)
+	return conditionalJSON(c, itemValidators(category, categoryKey), category)
 }
 
 // GetByID will get category by given ID
@@ -106,7 +125,7 @@ func (cat *CategoryHandler) GetByID(c echo.Context) error {
 		return c.JSON(getStatusCode(err), getErrorResponse(err))
 	}
 
-	return c.JSON(http.StatusOK, category)
+	return conditionalJSON(c, itemValidators(category, categoryKey), category)
 }
 
 func isCategoryRequestValid(m *domain.Category) (bool, error) {
@@ -201,6 +220,50 @@ func (cat *CategoryHandler) Update(c echo.Context) (err error) {
 	return c.JSON(http.StatusOK, updatedCategory)
 }
 
+// Move will put the category, along with its subtree, under another parent.
+// The body must carry parent_id, an explicit null makes the category a root category.
+func (cat *CategoryHandler) Move(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
+	}
+
+	parentID, err := parseMoveParent(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	ctx := c.Request().Context()
+	category, err := cat.Category.Move(ctx, id, parentID)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, category)
+}
+
+// parseMoveParent reads the parent_id of a move body, a missing key is an error rather than a move to the root
+func parseMoveParent(c echo.Context) (*uuid.UUID, error) {
+	var body map[string]json.RawMessage
+	if err := json.NewDecoder(c.Request().Body).Decode(&body); err != nil {
+		return nil, errors.New("the body must be a JSON object with a parent_id")
+	}
+
+	raw, ok := body["parent_id"]
+	if !ok {
+		return nil, errors.New("parent_id is required, null makes the category a root category")
+	}
+	if string(raw) == "null" {
+		return nil, nil
+	}
+
+	var parentID uuid.UUID
+	if err := json.Unmarshal(raw, &parentID); err != nil {
+		return nil, errors.New("parent_id must be a UUID or null")
+	}
+	return &parentID, nil
+}
+
 // Delete will delete category by given slug
 func (cat *CategoryHandler) Delete(c echo.Context) error {
 	idStr := c.Param("id")
@@ -217,8 +280,19 @@ func (cat *CategoryHandler) Delete(c echo.Context) error {
 		return c.JSON(getStatusCode(err), getErrorResponse(err))
 	}
 
+	// The strategy says where its children and articles go, see domain.CategoryDeleteStrategy
+	strategy := domain.CategoryDeleteStrategy(c.QueryParam("strategy"))
+	var targetID *uuid.UUID
+	if target := c.QueryParam("target"); target != "" {
+		parsedTargetID, err := uuid.Parse(target)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid target UUID format"})
+		}
+		targetID = &parsedTargetID
+	}
+
 	// Then delete by ID
-	err = cat.Category.Delete(ctx, category.ID)
+	err = cat.Category.Delete(ctx, category.ID, strategy, targetID)
 	if err != nil {
 		return c.JSON(getStatusCode(err), getErrorResponse(err))
 	}
@@ -226,16 +300,26 @@ func (cat *CategoryHandler) Delete(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
-// GetCategoryTree retrieves the complete category tree
+// GetCategoryTree retrieves the category tree, or the subtree of the ?root= category.
+// ?depth= limits how many levels come back, 1 only returns the top categories.
 func (cat *CategoryHandler) GetCategoryTree(c echo.Context) error {
+	depth := 0
+	if depthS := c.QueryParam("depth"); depthS != "" {
+		var err error
+		depth, err = strconv.Atoi(depthS)
+		if err != nil || depth < 1 {
+			return c.JSON(http.StatusBadRequest, ResponseError{Message: "depth must be a positive number"})
+		}
+	}
+
 	ctx := c.Request().Context()
 
-	categories, err := cat.Category.GetCategoryTree(ctx)
+	categories, err := cat.Category.GetCategoryTree(ctx, c.QueryParam("root"), depth)
 	if err != nil {
 		return c.JSON(getStatusCode(err), getErrorResponse(err))
 	}
 
-	return c.JSON(http.StatusOK, categories)
+	return conditionalJSON(c, listValidators(categories, categoryKey), categories)
 }
 
 // GetRootCategories retrieves all root categories
@@ -247,7 +331,7 @@ func (cat *CategoryHandler) GetRootCategories(c echo.Context) error {
 		return c.JSON(getStatusCode(err), getErrorResponse(err))
 	}
 
-	return c.JSON(http.StatusOK, categories)
+	return conditionalJSON(c, listValidators(categories, categoryKey), categories)
 }
 
 // GetChildren retrieves children of a category
@@ -272,5 +356,5 @@ func (cat *CategoryHandler) GetChildren(c echo.Context) error {
 		return c.JSON(getStatusCode(err), getErrorResponse(err))
 	}
 
-	return c.JSON(http.StatusOK, children)
+	return conditionalJSON(c, listValidators(children, categoryKey), children)
 }
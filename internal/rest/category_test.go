@@ -0,0 +1,42 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMoveParent(t *testing.T) {
+	parentID := uuid.New()
+
+	tests := []struct {
+		name    string
+		body    string
+		want    *uuid.UUID
+		wantErr bool
+	}{
+		{name: "parent", body: `{"parent_id": "` + parentID.String() + `"}`, want: &parentID},
+		{name: "explicit null", body: `{"parent_id": null}`},
+		{name: "missing key", body: `{"parent": null}`, wantErr: true},
+		{name: "empty object", body: `{}`, wantErr: true},
+		{name: "empty body", body: ``, wantErr: true},
+		{name: "not a uuid", body: `{"parent_id": "dubai"}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/categories/1/move", strings.NewReader(tt.body))
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			got, err := parseMoveParent(c)
+
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	validator "gopkg.in/go-playground/validator.v9"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// CommentService represent the comment's usecases
+//
+//go:generate mockery --name CommentService
+type CommentService interface {
+	FetchByArticle(ctx context.Context, articleID uuid.UUID) ([]domain.Comment, error)
+	FetchByStatus(ctx context.Context, status domain.CommentStatus, page, limit int) ([]domain.Comment, error)
+	Store(ctx context.Context, c *domain.Comment) error
+	Moderate(ctx context.Context, id uuid.UUID, status domain.CommentStatus) (domain.Comment, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// CommentHandler represent the httphandler for comment
+type CommentHandler struct {
+	Service CommentService
+}
+
+// ModerationRequest is the body of a moderation decision on a comment
+type ModerationRequest struct {
+	Status domain.CommentStatus `json:"status" validate:"required"`
+}
+
+// CommentPostRoute is the route readers post comments to, it doesn't require a token
+const CommentPostRoute = "/articles/:id/comments"
+
+// NewCommentHandler will initialize the comments resources endpoint
+func NewCommentHandler(e *echo.Echo, svc CommentService) {
+	handler := &CommentHandler{
+		Service: svc,
+	}
+	e.GET("/articles/:id/comments", handler.FetchByArticle)
+	e.POST(CommentPostRoute, handler.Store)
+	e.GET("/comments", handler.FetchByStatus)
+	e.PATCH("/comments/:id", handler.Moderate)
+	e.DELETE("/comments/:id", handler.Delete)
+}
+
+// FetchByArticle will fetch the comment threads of the article
+func (h *CommentHandler) FetchByArticle(c echo.Context) error {
+	articleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
+	}
+
+	comments, err := h.Service.FetchByArticle(c.Request().Context(), articleID)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return conditionalJSON(c, listValidators(comments, commentKey), comments)
+}
+
+// FetchByStatus will fetch the moderation queue, pending comments unless ?status= says otherwise
+func (h *CommentHandler) FetchByStatus(c echo.Context) error {
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil || page < 1 {
+		page = defaultPage
+	}
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit < 1 {
+		limit = defaultLimit
+	}
+
+	status := domain.CommentStatus(c.QueryParam("status"))
+	if status == "" {
+		status = domain.CommentPending
+	}
+
+	comments, err := h.Service.FetchByStatus(c.Request().Context(), status, page, limit)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, comments)
+}
+
+// Store will post a comment, or a reply when parent_id is given, on the article
+func (h *CommentHandler) Store(c echo.Context) (err error) {
+	articleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
+	}
+
+	var comment domain.Comment
+	err = c.Bind(&comment)
+	if err != nil {
+		return c.JSON(http.StatusUnprocessableEntity, err.Error())
+	}
+	comment.ArticleID = articleID
+
+	if err = validator.New().Struct(&comment); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	err = h.Service.Store(c.Request().Context(), &comment)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusCreated, comment)
+}
+
+// Moderate will approve a comment or mark it as spam
+func (h *CommentHandler) Moderate(c echo.Context) (err error) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
+	}
+
+	var req ModerationRequest
+	err = c.Bind(&req)
+	if err != nil {
+		return c.JSON(http.StatusUnprocessableEntity, err.Error())
+	}
+	if err = validator.New().Struct(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	comment, err := h.Service.Moderate(c.Request().Context(), id, req.Status)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, comment)
+}
+
+// Delete will delete the comment along with its replies
+func (h *CommentHandler) Delete(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
+	}
+
+	err = h.Service.Delete(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
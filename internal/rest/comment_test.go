@@ -0,0 +1,51 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bxcodec/go-clean-arch/comment"
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// noArticles answers every lookup like the article repository does for a missing article
+type noArticles struct{}
+
+func (noArticles) GetByID(context.Context, uuid.UUID) (domain.Article, error) {
+	return domain.Article{}, domain.ErrNotFound
+}
+
+func TestCommentsOfMissingArticle(t *testing.T) {
+	// The comment repository is never reached, any call panics
+	e := echo.New()
+	NewCommentHandler(e, comment.NewService(struct{ comment.CommentRepository }{}, noArticles{}))
+	path := "/articles/" + uuid.NewString() + "/comments"
+
+	tests := []struct {
+		name   string
+		method string
+		body   string
+	}{
+		{name: "fetch", method: http.MethodGet},
+		{name: "post", method: http.MethodPost, body: `{"author_name":"Ann","content":"Hello"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, path, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			res := httptest.NewRecorder()
+
+			e.ServeHTTP(res, req)
+
+			assert.Equal(t, http.StatusNotFound, res.Code)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package rest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// validators identify the representation of a read so clients can ask whether it changed.
+// The ETag covers everything the response shows, Last-Modified only follows the editorial changes.
+type validators struct {
+	etag         string
+	lastModified time.Time
+}
+
+// articleValidators lead the ETag with the article's version, the part writes send back in If-Match.
+// The rest of it covers what the response embeds without bumping the version, so the ETag is weak.
+func articleValidators(res domain.ArticleResponse) validators {
+	id, lastModified := articleResponseKey(res)
+	sum := sha256.Sum256([]byte(id))
+	etag := `W/"` + strconv.Itoa(res.Version) + "-" + hex.EncodeToString(sum[:8]) + `"`
+	return validators{etag: etag, lastModified: lastModified}
+}
+
+// listValidators derive weak validators for a list from the identity and last change of each item,
+// extra covers whatever else shapes the response, like totals or cursors
+func listValidators[T any](items []T, key func(T) (id string, updatedAt time.Time), extra ...string) validators {
+	var v validators
+	h := sha256.New()
+	for _, item := range items {
+		id, updatedAt := key(item)
+		h.Write([]byte(id + "@" + updatedAt.UTC().Format(time.RFC3339Nano) + "\n"))
+		if updatedAt.After(v.lastModified) {
+			v.lastModified = updatedAt
+		}
+	}
+	for _, e := range extra {
+		h.Write([]byte(e + "\n"))
+	}
+	v.etag = `W/"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+	return v
+}
+
+// itemValidators are the list validators of a single item
+func itemValidators[T any](item T, key func(T) (id string, updatedAt time.Time)) validators {
+	return listValidators([]T{item}, key)
+}
+
+// articleResponseKey covers the article version and counters, the author and the categories it embeds
+// and the breadcrumb, which moves with the category tree
+func articleResponseKey(res domain.ArticleResponse) (string, time.Time) {
+	var b strings.Builder
+	updatedAt := res.UpdatedAt
+	fmt.Fprintf(&b, "%s#%d views=%d likes=%d comments=%d", res.ID, res.Version, res.Views, res.Likes, res.Comments)
+
+	author, authorUpdatedAt := authorKey(res.Author)
+	fmt.Fprintf(&b, " author=%s@%s", author, authorUpdatedAt.UTC().Format(time.RFC3339Nano))
+	if authorUpdatedAt.After(updatedAt) {
+		updatedAt = authorUpdatedAt
+	}
+	for _, cat := range res.Categories {
+		fmt.Fprintf(&b, " category=%s@%s", cat.ID, cat.UpdatedAt.UTC().Format(time.RFC3339Nano))
+		if cat.UpdatedAt.After(updatedAt) {
+			updatedAt = cat.UpdatedAt
+		}
+	}
+	for _, item := range res.Breadcrumb {
+		fmt.Fprintf(&b, " crumb=%s|%s", item.Name, item.Link)
+	}
+	return b.String(), updatedAt
+}
+
+func authorKey(a domain.Author) (string, time.Time) {
+	return a.ID.String(), a.UpdatedAt
+}
+
+func tagKey(t domain.Tag) (string, time.Time) {
+	return t.ID.String(), t.UpdatedAt
+}
+
+// tagCountKey covers the count too as it moves without the tag changing
+func tagCountKey(t domain.TagCount) (string, time.Time) {
+	id, updatedAt := tagKey(t.Tag)
+	return id + "=" + strconv.Itoa(t.Articles), updatedAt
+}
+
+// commentKey covers the comment and the replies nested under it
+func commentKey(cm domain.Comment) (string, time.Time) {
+	id, updatedAt := cm.ID.String()+"@"+cm.UpdatedAt.UTC().Format(time.RFC3339Nano), cm.UpdatedAt
+	for _, reply := range cm.Replies {
+		replyID, replyUpdatedAt := commentKey(reply)
+		id += "," + replyID
+		if replyUpdatedAt.After(updatedAt) {
+			updatedAt = replyUpdatedAt
+		}
+	}
+	return id, updatedAt
+}
+
+// categoryKey covers the category and the subtree nested under it
+func categoryKey(cat domain.Category) (string, time.Time) {
+	id, updatedAt := cat.ID.String()+"@"+cat.UpdatedAt.UTC().Format(time.RFC3339Nano), cat.UpdatedAt
+	for _, child := range cat.Children {
+		childID, childUpdatedAt := categoryKey(child)
+		id += "," + childID
+		if childUpdatedAt.After(updatedAt) {
+			updatedAt = childUpdatedAt
+		}
+	}
+	return id, updatedAt
+}
+
+// setValidators sets the ETag and Last-Modified headers of the response
+func setValidators(c echo.Context, v validators) {
+	header := c.Response().Header()
+	if v.etag != "" {
+		header.Set(headerETag, v.etag)
+	}
+	if !v.lastModified.IsZero() {
+		header.Set(echo.HeaderLastModified, v.lastModified.UTC().Format(http.TimeFormat))
+	}
+}
+
+// conditionalJSON sends the validators along with body, or 304 Not Modified without a body
+// when the client's copy matches them
+func conditionalJSON(c echo.Context, v validators, body interface{}) error {
+	setValidators(c, v)
+	if notModified(c.Request(), v) {
+		return c.NoContent(http.StatusNotModified)
+	}
+	return c.JSON(http.StatusOK, body)
+}
+
+// notModified evaluates If-None-Match, or If-Modified-Since when there is no If-None-Match, as RFC 9110 orders them
+func notModified(req *http.Request, v validators) bool {
+	if inm := req.Header.Get(headerIfNoneMatch); inm != "" {
+		return v.etag != "" && etagListMatches(inm, v.etag)
+	}
+
+	if ims := req.Header.Get(echo.HeaderIfModifiedSince); ims != "" && !v.lastModified.IsZero() {
+		since, err := http.ParseTime(ims)
+		if err != nil {
+			return false
+		}
+		// Last-Modified only has second precision
+		return !v.lastModified.Truncate(time.Second).After(since)
+	}
+	return false
+}
+
+// etagListMatches uses the weak comparison If-None-Match calls for
+func etagListMatches(list, etag string) bool {
+	for _, candidate := range strings.Split(list, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
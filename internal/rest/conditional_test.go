@@ -0,0 +1,88 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+func TestConditionalJSON(t *testing.T) {
+	updatedAt := time.Date(2024, 3, 1, 10, 0, 0, 500, time.UTC)
+	v := articleValidators(domain.ArticleResponse{Article: domain.Article{Version: 4, UpdatedAt: updatedAt}})
+
+	tests := []struct {
+		name   string
+		header string
+		value  string
+		want   int
+	}{
+		{name: "no condition", want: http.StatusOK},
+		{name: "same etag", header: headerIfNoneMatch, value: v.etag, want: http.StatusNotModified},
+		{name: "etag in list", header: headerIfNoneMatch, value: `W/"3-00", ` + v.etag, want: http.StatusNotModified},
+		{name: "stale etag", header: headerIfNoneMatch, value: `W/"3-00"`, want: http.StatusOK},
+		{name: "not modified since", header: echo.HeaderIfModifiedSince, value: updatedAt.Format(http.TimeFormat), want: http.StatusNotModified},
+		{name: "modified since", header: echo.HeaderIfModifiedSince, value: updatedAt.Add(-time.Minute).Format(http.TimeFormat), want: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/articles/1", nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			err := conditionalJSON(c, v, map[string]string{"title": "hello"})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, rec.Code)
+			assert.Equal(t, v.etag, rec.Header().Get(headerETag))
+			assert.Equal(t, "Fri, 01 Mar 2024 10:00:00 GMT", rec.Header().Get(echo.HeaderLastModified))
+			if tt.want == http.StatusNotModified {
+				assert.Empty(t, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestListValidatorsFollowChanges(t *testing.T) {
+	updatedAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	cats := []domain.Category{{ID: uuid.New(), UpdatedAt: updatedAt}}
+	child := domain.Category{ID: uuid.New(), UpdatedAt: updatedAt.Add(time.Hour)}
+
+	before := listValidators(cats, categoryKey)
+	cats[0].Children = []domain.Category{child}
+	after := listValidators(cats, categoryKey)
+
+	assert.NotEqual(t, before.etag, after.etag)
+	assert.Equal(t, updatedAt, before.lastModified)
+	assert.Equal(t, child.UpdatedAt, after.lastModified)
+}
+
+func TestArticleValidatorsFollowEmbeddedContent(t *testing.T) {
+	updatedAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	res := domain.ArticleResponse{Article: domain.Article{ID: uuid.New(), Version: 4, UpdatedAt: updatedAt}}
+	base := articleValidators(res)
+	assert.True(t, strings.HasPrefix(base.etag, `W/"4-`))
+
+	renamed := res
+	renamed.Author = domain.Author{ID: uuid.New(), UpdatedAt: updatedAt.Add(time.Hour)}
+	moved := res
+	moved.Breadcrumb = []domain.BreadcrumbItem{{Name: "Dubai", Link: "/categories/uae/dubai"}}
+	liked := res
+	liked.Likes++
+
+	for _, changed := range []domain.ArticleResponse{renamed, moved, liked} {
+		assert.NotEqual(t, base.etag, articleValidators(changed).etag)
+	}
+	assert.Equal(t, renamed.Author.UpdatedAt, articleValidators(renamed).lastModified)
+}
@@ -0,0 +1,40 @@
+package rest
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+const (
+	headerETag        = "ETag"
+	headerIfMatch     = "If-Match"
+	headerIfNoneMatch = "If-None-Match"
+)
+
+// ifMatchVersion returns the version the request's If-Match header applies to, only the version part
+// of the ETags reads send is compared. "*" gives domain.AnyVersion, matching whatever version is current.
+// A missing header gives ErrPreconditionRequired, one that can't match any version ErrPreconditionFailed.
+func ifMatchVersion(c echo.Context) (int, error) {
+	header := strings.TrimSpace(c.Request().Header.Get(headerIfMatch))
+	if header == "" {
+		return 0, domain.ErrPreconditionRequired
+	}
+	if header == "*" {
+		return domain.AnyVersion, nil
+	}
+
+	tag, err := strconv.Unquote(strings.TrimPrefix(header, "W/"))
+	if err != nil {
+		return 0, domain.ErrPreconditionFailed
+	}
+	tag, _, _ = strings.Cut(tag, "-")
+	version, err := strconv.Atoi(tag)
+	if err != nil {
+		return 0, domain.ErrPreconditionFailed
+	}
+	return version, nil
+}
@@ -0,0 +1,44 @@
+package rest
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+func TestIfMatchVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		ifMatch string
+		want    int
+		wantErr error
+	}{
+		{name: "current etag", ifMatch: articleValidators(domain.ArticleResponse{Article: domain.Article{Version: 3}}).etag, want: 3},
+		{name: "strong", ifMatch: `"3"`, want: 3},
+		{name: "missing", ifMatch: "", wantErr: domain.ErrPreconditionRequired},
+		{name: "any", ifMatch: "*", want: domain.AnyVersion},
+		{name: "unquoted", ifMatch: "3", wantErr: domain.ErrPreconditionFailed},
+		{name: "weak", ifMatch: `W/"3"`, want: 3},
+		{name: "read etag", ifMatch: `W/"3-0a1b2c3d"`, want: 3},
+		{name: "not a version", ifMatch: `"abc"`, wantErr: domain.ErrPreconditionFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("PATCH", "/articles/1", nil)
+			if tt.ifMatch != "" {
+				req.Header.Set(headerIfMatch, tt.ifMatch)
+			}
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			version, err := ifMatchVersion(c)
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, version)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package rest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// clientFingerprint identifies the client behind a request: signed-in users by their id,
+// anonymous readers by a hash of their IP address and user agent so neither is kept as is.
+// The IP address comes from the server's IPExtractor, which only trusts forwarding headers from known proxies.
+func clientFingerprint(c echo.Context) string {
+	if user, ok := domain.UserFromContext(c.Request().Context()); ok {
+		return "user:" + user.ID.String()
+	}
+
+	sum := sha256.Sum256([]byte(c.RealIP() + "|" + c.Request().UserAgent()))
+	return "anon:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,60 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// LikeService represent the like's usecases
+//
+//go:generate mockery --name LikeService
+type LikeService interface {
+	Like(ctx context.Context, articleID uuid.UUID, userKey string) (domain.LikeStatus, error)
+	Unlike(ctx context.Context, articleID uuid.UUID, userKey string) (domain.LikeStatus, error)
+}
+
+// LikeHandler represent the httphandler for article likes
+type LikeHandler struct {
+	Service LikeService
+}
+
+// LikeRoute is the route readers like and unlike articles on, it doesn't require a token
+const LikeRoute = "/articles/:id/like"
+
+// NewLikeHandler will initialize the article like endpoints
+func NewLikeHandler(e *echo.Echo, svc LikeService) {
+	handler := &LikeHandler{
+		Service: svc,
+	}
+	e.POST(LikeRoute, handler.Like)
+	e.DELETE(LikeRoute, handler.Unlike)
+}
+
+// Like will like the article on behalf of the requesting client
+func (h *LikeHandler) Like(c echo.Context) error {
+	return h.handle(c, h.Service.Like)
+}
+
+// Unlike will take back the like of the requesting client
+func (h *LikeHandler) Unlike(c echo.Context) error {
+	return h.handle(c, h.Service.Unlike)
+}
+
+func (h *LikeHandler) handle(c echo.Context, action func(context.Context, uuid.UUID, string) (domain.LikeStatus, error)) error {
+	articleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
+	}
+
+	status, err := action(c.Request().Context(), articleID, clientFingerprint(c))
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, status)
+}
@@ -0,0 +1,41 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+
+	"github.com/bxcodec/go-clean-arch/internal/repository/mysql"
+	"github.com/bxcodec/go-clean-arch/like"
+)
+
+func TestLikeOfMissingArticle(t *testing.T) {
+	for _, method := range []string{http.MethodPost, http.MethodDelete} {
+		t.Run(method, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+			id := uuid.New()
+			mock.ExpectQuery(`FROM article WHERE ID = \?`).
+				WithArgs(id.String()).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+			// The like repository is never reached, any call panics
+			e := echo.New()
+			NewLikeHandler(e, like.NewService(nil, mysql.NewArticleRepository(db)))
+			req := httptest.NewRequest(method, "/articles/"+id.String()+"/like", nil)
+			res := httptest.NewRecorder()
+
+			e.ServeHTTP(res, req)
+
+			assert.Equal(t, http.StatusNotFound, res.Code)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	echo "github.com/labstack/echo/v4"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// Authenticator resolves a bearer token into the user it was issued for
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (domain.User, error)
+}
+
+// Authenticate will attach the user of a valid bearer token to the request context.
+// Safe methods (GET, HEAD, OPTIONS) stay public and are served anonymously when the
+// token is missing, expired or invalid, every other method is rejected with 401
+// unless it carries a valid token or its route is listed in publicRoutes.
+func Authenticate(a Authenticator, publicRoutes ...string) echo.MiddlewareFunc {
+	public := make(map[string]bool, len(publicRoutes))
+	for _, route := range publicRoutes {
+		public[route] = true
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			anonymous := isSafeMethod(req.Method) || public[c.Path()]
+
+			token, found := bearerToken(req.Header.Get(echo.HeaderAuthorization))
+			if !found {
+				if anonymous {
+					return next(c)
+				}
+				return echo.NewHTTPError(http.StatusUnauthorized, domain.ErrUnauthorized.Error())
+			}
+
+			user, err := a.Authenticate(req.Context(), token)
+			if err != nil {
+				// A stale token must not lock a client out of what it could read without one
+				if anonymous {
+					return next(c)
+				}
+				return echo.NewHTTPError(http.StatusUnauthorized, domain.ErrUnauthorized.Error())
+			}
+
+			c.SetRequest(req.WithContext(domain.NewContextWithUser(req.Context(), user)))
+			return next(c)
+		}
+	}
+}
+
+func bearerToken(header string) (string, bool) {
+	const prefix = "Bearer "
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return "", false
+	}
+	return strings.TrimSpace(header[len(prefix):]), true
+}
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,80 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	test "net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+	"github.com/bxcodec/go-clean-arch/internal/rest/middleware"
+)
+
+type fakeAuthenticator struct {
+	user domain.User
+}
+
+func (f fakeAuthenticator) Authenticate(_ context.Context, token string) (domain.User, error) {
+	if token != "valid" {
+		return domain.User{}, domain.ErrUnauthorized
+	}
+	return f.user, nil
+}
+
+func TestAuthenticate(t *testing.T) {
+	user := domain.User{ID: uuid.New(), Email: "admin@example.com"}
+	mw := middleware.Authenticate(fakeAuthenticator{user: user}, "/auth/login")
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		authHeader string
+		wantErr    bool
+		wantUser   bool
+	}{
+		{name: "public read", method: http.MethodGet, path: "/articles"},
+		{name: "write without token", method: http.MethodPost, path: "/articles", wantErr: true},
+		{name: "write with invalid token", method: http.MethodPost, path: "/articles", authHeader: "Bearer nope", wantErr: true},
+		{name: "write with valid token", method: http.MethodPost, path: "/articles", authHeader: "Bearer valid", wantUser: true},
+		{name: "read with valid token", method: http.MethodGet, path: "/articles", authHeader: "Bearer valid", wantUser: true},
+		{name: "public write route", method: http.MethodPost, path: "/auth/login"},
+		{name: "read with invalid token", method: http.MethodGet, path: "/articles", authHeader: "Bearer expired"},
+		{name: "head with invalid token", method: http.MethodHead, path: "/articles", authHeader: "Bearer expired"},
+		{name: "public write route with invalid token", method: http.MethodPost, path: "/auth/login", authHeader: "Bearer expired"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := test.NewRequest(tt.method, tt.path, nil)
+			if tt.authHeader != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.authHeader)
+			}
+			res := test.NewRecorder()
+			c := e.NewContext(req, res)
+			c.SetPath(tt.path)
+
+			var gotUser bool
+			h := mw(func(c echo.Context) error {
+				_, gotUser = domain.UserFromContext(c.Request().Context())
+				return c.NoContent(http.StatusOK)
+			})
+
+			err := h(c)
+			if tt.wantErr {
+				var httpErr *echo.HTTPError
+				require.ErrorAs(t, err, &httpErr)
+				assert.Equal(t, http.StatusUnauthorized, httpErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUser, gotUser)
+		})
+	}
+}
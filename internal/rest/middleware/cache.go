@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"net/http"
+
+	echo "github.com/labstack/echo/v4"
+)
+
+const privateCacheControl = "private, no-cache"
+
+// CacheControl sets the Cache-Control header of successful reads. Anonymous reads get publicValue
+// so shared caches like the CDN can keep them, authenticated ones are private to the user.
+// Handlers that set their own Cache-Control keep it.
+func CacheControl(publicValue string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if req.Method != http.MethodGet && req.Method != http.MethodHead {
+				return next(c)
+			}
+
+			res := c.Response()
+			res.Before(func() {
+				if res.Status != http.StatusOK && res.Status != http.StatusNotModified {
+					return
+				}
+				res.Header().Add(echo.HeaderVary, echo.HeaderAuthorization)
+				if res.Header().Get(echo.HeaderCacheControl) != "" {
+					return
+				}
+				value := publicValue
+				if req.Header.Get(echo.HeaderAuthorization) != "" {
+					value = privateCacheControl
+				}
+				res.Header().Set(echo.HeaderCacheControl, value)
+			})
+			return next(c)
+		}
+	}
+}
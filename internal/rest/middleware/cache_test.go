@@ -0,0 +1,47 @@
+package middleware_test
+
+import (
+	"net/http"
+	test "net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bxcodec/go-clean-arch/internal/rest/middleware"
+)
+
+func TestCacheControl(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		token  string
+		status int
+		want   string
+	}{
+		{name: "anonymous read", method: echo.GET, status: http.StatusOK, want: "public, max-age=60"},
+		{name: "not modified", method: echo.GET, status: http.StatusNotModified, want: "public, max-age=60"},
+		{name: "authenticated read", method: echo.GET, token: "Bearer token", status: http.StatusOK, want: "private, no-cache"},
+		{name: "failed read", method: echo.GET, status: http.StatusNotFound, want: ""},
+		{name: "write", method: echo.POST, status: http.StatusOK, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := test.NewRequest(tt.method, "/articles", nil)
+			if tt.token != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.token)
+			}
+			res := test.NewRecorder()
+			c := echo.New().NewContext(req, res)
+
+			h := middleware.CacheControl("public, max-age=60")(func(c echo.Context) error {
+				return c.NoContent(tt.status)
+			})
+
+			require.NoError(t, h(c))
+			assert.Equal(t, tt.want, res.Header().Get(echo.HeaderCacheControl))
+		})
+	}
+}
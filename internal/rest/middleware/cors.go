@@ -37,7 +37,8 @@ func CORS(next echo.HandlerFunc) echo.HandlerFunc {
 
 		// Set other CORS headers
 		c.Response().Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-		c.Response().Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With")
+		c.Response().Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With, If-Match, If-None-Match, If-Modified-Since")
+		c.Response().Header().Set("Access-Control-Expose-Headers", "ETag, Link")
 		c.Response().Header().Set("Access-Control-Allow-Credentials", "true")
 		c.Response().Header().Set("Access-Control-Max-Age", "86400")
 
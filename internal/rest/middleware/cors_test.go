@@ -25,4 +25,5 @@ func TestCORS(t *testing.T) {
 	err := h(c)
 	require.NoError(t, err)
 	assert.Equal(t, "*", res.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "ETag, Link", res.Header().Get("Access-Control-Expose-Headers"))
 }
@@ -0,0 +1,97 @@
+package rest
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// PageResponse is the envelope of page based listings
+type PageResponse struct {
+	Data       interface{} `json:"data"`
+	Total      int         `json:"total"`
+	Page       int         `json:"page"`
+	Limit      int         `json:"limit"`
+	TotalPages int         `json:"total_pages"`
+}
+
+// CursorResponse is the envelope of keyset paginated listings
+type CursorResponse struct {
+	Data interface{} `json:"data"`
+	domain.PageInfo
+	Limit int `json:"limit"`
+}
+
+func newPageResponse(data interface{}, total, page, limit int) PageResponse {
+	return PageResponse{
+		Data:       data,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: (total + limit - 1) / limit,
+	}
+}
+
+// setPageLinks sets the RFC 5988 Link header pointing at the first, previous, next and last pages
+func setPageLinks(c echo.Context, res PageResponse) {
+	pageURL := func(page int) string {
+		return linkURL(c, "page", strconv.Itoa(page))
+	}
+
+	var links []string
+	if res.TotalPages > 0 {
+		links = append(links, link(pageURL(1), "first"))
+	}
+	if res.Page > 1 && res.Page <= res.TotalPages {
+		links = append(links, link(pageURL(res.Page-1), "prev"))
+	}
+	if res.Page < res.TotalPages {
+		links = append(links, link(pageURL(res.Page+1), "next"))
+	}
+	if res.TotalPages > 0 {
+		links = append(links, link(pageURL(res.TotalPages), "last"))
+	}
+	setLinkHeader(c, links)
+}
+
+// setCursorLinks sets the RFC 5988 Link header pointing at the neighbouring pages of a keyset paginated listing
+func setCursorLinks(c echo.Context, page domain.PageInfo) {
+	var links []string
+	if page.PrevCursor != "" {
+		links = append(links, link(linkURL(c, "cursor", page.PrevCursor), "prev"))
+	}
+	if page.NextCursor != "" {
+		links = append(links, link(linkURL(c, "cursor", page.NextCursor), "next"))
+	}
+	setLinkHeader(c, links)
+}
+
+// linkURL returns the URL of the current request with the given query parameter replaced
+func linkURL(c echo.Context, key, value string) string {
+	req := c.Request()
+	query := req.URL.Query()
+	query.Set(key, value)
+
+	u := url.URL{
+		Scheme:   c.Scheme(),
+		Host:     req.Host,
+		Path:     req.URL.Path,
+		RawQuery: query.Encode(),
+	}
+	return u.String()
+}
+
+func link(target, rel string) string {
+	return fmt.Sprintf(`<%s>; rel="%s"`, target, rel)
+}
+
+func setLinkHeader(c echo.Context, links []string) {
+	if len(links) > 0 {
+		c.Response().Header().Set("Link", strings.Join(links, ", "))
+	}
+}
@@ -0,0 +1,56 @@
+package rest
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetPageLinks(t *testing.T) {
+	tests := []struct {
+		name  string
+		page  int
+		total int
+		want  string
+	}{
+		{
+			name:  "middle page",
+			page:  2,
+			total: 25,
+			want: `<http://example.com/articles?limit=10&page=1&tag=dubai>; rel="first", ` +
+				`<http://example.com/articles?limit=10&page=1&tag=dubai>; rel="prev", ` +
+				`<http://example.com/articles?limit=10&page=3&tag=dubai>; rel="next", ` +
+				`<http://example.com/articles?limit=10&page=3&tag=dubai>; rel="last"`,
+		},
+		{
+			name:  "single page",
+			page:  1,
+			total: 4,
+			want: `<http://example.com/articles?limit=10&page=1&tag=dubai>; rel="first", ` +
+				`<http://example.com/articles?limit=10&page=1&tag=dubai>; rel="last"`,
+		},
+		{
+			name:  "no results",
+			page:  1,
+			total: 0,
+			want:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(echo.GET, "/articles?tag=dubai&page=2&limit=10", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			res := newPageResponse(nil, tt.total, tt.page, 10)
+			setPageLinks(c, res)
+
+			assert.Equal(t, tt.want, rec.Header().Get("Link"))
+			assert.Equal(t, (tt.total+9)/10, res.TotalPages)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	validator "gopkg.in/go-playground/validator.v9"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// TagService represent the tag's usecases
+//
+//go:generate mockery --name TagService
+type TagService interface {
+	Fetch(ctx context.Context) ([]domain.Tag, error)
+	GetBySlug(ctx context.Context, slug string) (domain.Tag, error)
+	Cloud(ctx context.Context, limit int) ([]domain.TagCount, error)
+	Rename(ctx context.Context, slug, name string) (domain.Tag, error)
+}
+
+// TagHandler represent the httphandler for tag
+type TagHandler struct {
+	Service TagService
+}
+
+// RenameTagRequest is the body of a tag rename
+type RenameTagRequest struct {
+	Name string `json:"name" validate:"required,max=100"`
+}
+
+const defaultTagCloudLimit = 50
+
+// NewTagHandler will initialize the tags/ resources endpoint
+func NewTagHandler(e *echo.Echo, svc TagService) {
+	handler := &TagHandler{
+		Service: svc,
+	}
+	e.GET("/tags", handler.FetchTag)
+	e.GET("/tags/cloud", handler.Cloud)
+	e.GET("/tags/:slug", handler.GetBySlug)
+	e.PATCH("/tags/:slug", handler.Rename)
+}
+
+// FetchTag will fetch every tag
+func (h *TagHandler) FetchTag(c echo.Context) error {
+	tags, err := h.Service.Fetch(c.Request().Context())
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return conditionalJSON(c, listValidators(tags, tagKey), tags)
+}
+
+// Cloud will fetch the most used tags with their article counts
+func (h *TagHandler) Cloud(c echo.Context) error {
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit < 1 {
+		limit = defaultTagCloudLimit
+	}
+
+	cloud, err := h.Service.Cloud(c.Request().Context(), limit)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return conditionalJSON(c, listValidators(cloud, tagCountKey), cloud)
+}
+
+// GetBySlug will get tag by given slug
+func (h *TagHandler) GetBySlug(c echo.Context) error {
+	t, err := h.Service.GetBySlug(c.Request().Context(), c.Param("slug"))
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return conditionalJSON(c, itemValidators(t, tagKey), t)
+}
+
+// Rename will rename the tag on every article
+func (h *TagHandler) Rename(c echo.Context) (err error) {
+	var req RenameTagRequest
+	err = c.Bind(&req)
+	if err != nil {
+		return c.JSON(http.StatusUnprocessableEntity, err.Error())
+	}
+	if err = validator.New().Struct(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, err.Error())
+	}
+
+	t, err := h.Service.Rename(c.Request().Context(), c.Param("slug"), req.Name)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, t)
+}
@@ -0,0 +1,78 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// TrashService represent the trash's usecases
+//
+//go:generate mockery --name TrashService
+type TrashService interface {
+	Fetch(ctx context.Context, page, limit int) (domain.Trash, error)
+	RestoreArticle(ctx context.Context, id uuid.UUID) error
+	RestoreCategory(ctx context.Context, id uuid.UUID) error
+}
+
+// TrashHandler represent the httphandler for the trash
+type TrashHandler struct {
+	Service TrashService
+}
+
+// NewTrashHandler will initialize the trash/ resources endpoint
+func NewTrashHandler(e *echo.Echo, svc TrashService) {
+	handler := &TrashHandler{
+		Service: svc,
+	}
+	e.GET("/trash", handler.FetchTrash)
+	e.POST("/trash/articles/:id/restore", handler.RestoreArticle)
+	e.POST("/trash/categories/:id/restore", handler.RestoreCategory)
+}
+
+// FetchTrash will fetch the deleted articles and categories, most recently deleted first
+func (h *TrashHandler) FetchTrash(c echo.Context) error {
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil || page < 1 {
+		page = defaultPage
+	}
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit < 1 {
+		limit = defaultLimit
+	}
+
+	trash, err := h.Service.Fetch(c.Request().Context(), page, limit)
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, trash)
+}
+
+// RestoreArticle will take the article out of the trash
+func (h *TrashHandler) RestoreArticle(c echo.Context) error {
+	return h.restore(c, h.Service.RestoreArticle)
+}
+
+// RestoreCategory will take the category out of the trash
+func (h *TrashHandler) RestoreCategory(c echo.Context) error {
+	return h.restore(c, h.Service.RestoreCategory)
+}
+
+func (h *TrashHandler) restore(c echo.Context, restore func(ctx context.Context, id uuid.UUID) error) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
+	}
+
+	if err = restore(c.Request().Context(), id); err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,47 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// ViewService represent the view counting usecases
+//
+//go:generate mockery --name ViewService
+type ViewService interface {
+	Record(ctx context.Context, articleID uuid.UUID, fingerprint string) error
+}
+
+// ViewHandler represent the httphandler for article views
+type ViewHandler struct {
+	Service ViewService
+}
+
+// ViewRoute is the route readers report article views to, it doesn't require a token
+const ViewRoute = "/articles/:id/view"
+
+// NewViewHandler will initialize the article view endpoint
+func NewViewHandler(e *echo.Echo, svc ViewService) {
+	handler := &ViewHandler{
+		Service: svc,
+	}
+	e.POST(ViewRoute, handler.Record)
+}
+
+// Record will count a view of the article by the requesting client
+func (h *ViewHandler) Record(c echo.Context) error {
+	articleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: "Invalid UUID format"})
+	}
+
+	err = h.Service.Record(c.Request().Context(), articleID, clientFingerprint(c))
+	if err != nil {
+		return c.JSON(getStatusCode(err), getErrorResponse(err))
+	}
+
+	return c.NoContent(http.StatusAccepted)
+}
@@ -0,0 +1,38 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
+
+	"github.com/bxcodec/go-clean-arch/internal/repository/mysql"
+	"github.com/bxcodec/go-clean-arch/view"
+)
+
+func TestViewOfMissingArticle(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	id := uuid.New()
+	mock.ExpectQuery(`FROM article WHERE ID = \?`).
+		WithArgs(id.String()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	// Nothing gets buffered, so the view repository is never reached
+	e := echo.New()
+	NewViewHandler(e, view.NewService(nil, mysql.NewArticleRepository(db), time.Hour))
+	req := httptest.NewRequest(http.MethodPost, "/articles/"+id.String()+"/view", nil)
+	res := httptest.NewRecorder()
+
+	e.ServeHTTP(res, req)
+
+	assert.Equal(t, http.StatusNotFound, res.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,48 @@
+// Package slug builds the URL slugs of articles, authors and categories
+package slug
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	invalidChars = regexp.MustCompile(`[^a-z0-9\s-]`)
+	dashes       = regexp.MustCompile(`-+`)
+)
+
+// ExistsFunc reports whether slug is already taken by an item other than excludeID
+type ExistsFunc func(ctx context.Context, slug string, excludeID uuid.UUID) (bool, error)
+
+// Generate turns a name or a title into a slug
+func Generate(name string) string {
+	slug := strings.ToLower(name)
+	slug = invalidChars.ReplaceAllString(slug, "")
+	slug = strings.ReplaceAll(slug, " ", "-")
+	slug = dashes.ReplaceAllString(slug, "-")
+	return strings.Trim(slug, "-")
+}
+
+// Unique returns base, or base followed by the first counter that makes it free.
+// The item being renamed is excluded so it can keep its own slug.
+func Unique(ctx context.Context, base string, excludeID uuid.UUID, exists ExistsFunc) string {
+	slug := base
+	counter := 1
+
+	for {
+		taken, err := exists(ctx, slug, excludeID)
+		if err != nil || !taken {
+			break
+		}
+
+		// Generate new slug with counter
+		slug = fmt.Sprintf("%s-%d", base, counter)
+		counter++
+	}
+
+	return slug
+}
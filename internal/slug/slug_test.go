@@ -0,0 +1,24 @@
+package slug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	assert.Equal(t, "hotels-resorts", Generate("Hotels & Resorts"))
+	assert.Equal(t, "dubai-marina", Generate("  Dubai -- Marina! "))
+}
+
+func TestUnique(t *testing.T) {
+	taken := map[string]bool{"dubai": true, "dubai-1": true}
+	exists := func(_ context.Context, slug string, _ uuid.UUID) (bool, error) {
+		return taken[slug], nil
+	}
+
+	assert.Equal(t, "dubai-2", Unique(context.Background(), "dubai", uuid.Nil, exists))
+	assert.Equal(t, "abu-dhabi", Unique(context.Background(), "abu-dhabi", uuid.Nil, exists))
+}
@@ -0,0 +1,66 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const publishBatchSize = 100
+
+// ScheduledArticleRepository represent the repository contract used by the publisher
+//
+//go:generate mockery --name ScheduledArticleRepository
+type ScheduledArticleRepository interface {
+	PublishDue(ctx context.Context, now time.Time, limit int) (int64, error)
+}
+
+// Publisher periodically publishes the articles whose scheduled publish date has been reached
+type Publisher struct {
+	repo     ScheduledArticleRepository
+	interval time.Duration
+}
+
+// NewPublisher will create a publisher checking for due articles every interval
+func NewPublisher(repo ScheduledArticleRepository, interval time.Duration) *Publisher {
+	return &Publisher{
+		repo:     repo,
+		interval: interval,
+	}
+}
+
+// Run publishes due articles right away and then on every tick until ctx is done.
+// Failures are logged and retried on the next tick.
+func (p *Publisher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.publishDue(ctx); err != nil && ctx.Err() == nil {
+			logrus.Error("failed to publish scheduled articles: ", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// publishDue drains the due articles in batches so a backlog doesn't hold locks for long
+func (p *Publisher) publishDue(ctx context.Context) error {
+	for {
+		n, err := p.repo.PublishDue(ctx, time.Now(), publishBatchSize)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			logrus.Infof("published %d scheduled articles", n)
+		}
+		if n < publishBatchSize || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeScheduledRepo hands out the given batch sizes and stops the publisher after the last one
+type fakeScheduledRepo struct {
+	batches []int64
+	err     error
+	calls   int
+	stop    context.CancelFunc
+}
+
+func (f *fakeScheduledRepo) PublishDue(_ context.Context, _ time.Time, _ int) (int64, error) {
+	f.calls++
+	if f.err != nil || f.calls == len(f.batches) {
+		f.stop()
+	}
+	if f.err != nil {
+		return 0, f.err
+	}
+	return f.batches[f.calls-1], nil
+}
+
+func TestPublisherRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		batches   []int64
+		err       error
+		wantCalls int
+	}{
+		{
+			name:      "drains full batches",
+			batches:   []int64{publishBatchSize, publishBatchSize, 3},
+			wantCalls: 3,
+		},
+		{
+			name:      "nothing due",
+			batches:   []int64{0},
+			wantCalls: 1,
+		},
+		{
+			name:      "repository failure",
+			err:       errors.New("db down"),
+			wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			repo := &fakeScheduledRepo{batches: tt.batches, err: tt.err, stop: cancel}
+			p := NewPublisher(repo, time.Hour)
+
+			done := make(chan error)
+			go func() { done <- p.Run(ctx) }()
+
+			select {
+			case err := <-done:
+				require.NoError(t, err)
+			case <-time.After(time.Second):
+				t.Fatal("publisher did not stop")
+			}
+			assert.Equal(t, tt.wantCalls, repo.calls)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// TrashPurger represent the trash contract used by the purger
+//
+//go:generate mockery --name TrashPurger
+type TrashPurger interface {
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Purger periodically hard-deletes the items that stayed in the trash longer than the retention period
+type Purger struct {
+	trash     TrashPurger
+	interval  time.Duration
+	retention time.Duration
+}
+
+// NewPurger will create a purger emptying the trash of items older than retention every interval
+func NewPurger(trash TrashPurger, interval, retention time.Duration) *Purger {
+	return &Purger{
+		trash:     trash,
+		interval:  interval,
+		retention: retention,
+	}
+}
+
+// Run purges expired items right away and then on every tick until ctx is done.
+// Failures are logged and retried on the next tick.
+func (p *Purger) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := p.trash.Purge(ctx, time.Now().Add(-p.retention))
+		if err != nil && ctx.Err() == nil {
+			logrus.Error("failed to purge the trash: ", err)
+		}
+		if n > 0 {
+			logrus.Infof("purged %d items from the trash", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package workers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTrash struct {
+	before time.Time
+	stop   context.CancelFunc
+}
+
+func (f *fakeTrash) Purge(_ context.Context, before time.Time) (int64, error) {
+	f.before = before
+	f.stop()
+	return 1, nil
+}
+
+func TestPurgerRunUsesRetention(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	trash := &fakeTrash{stop: cancel}
+
+	start := time.Now()
+	err := NewPurger(trash, time.Hour, 30*24*time.Hour).Run(ctx)
+
+	require.NoError(t, err)
+	assert.WithinDuration(t, start.Add(-30*24*time.Hour), trash.before, time.Minute)
+}
@@ -0,0 +1,55 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Flusher represent the contract of services buffering writes in memory
+//
+//go:generate mockery --name Flusher
+type Flusher interface {
+	Flush(ctx context.Context) error
+}
+
+// ViewFlusher periodically writes the buffered article views to the database
+type ViewFlusher struct {
+	flusher  Flusher
+	interval time.Duration
+	timeout  time.Duration
+}
+
+// NewViewFlusher will create a worker flushing the buffered views every interval.
+// The last flush on shutdown is given timeout to complete.
+func NewViewFlusher(f Flusher, interval, timeout time.Duration) *ViewFlusher {
+	return &ViewFlusher{
+		flusher:  f,
+		interval: interval,
+		timeout:  timeout,
+	}
+}
+
+// Run flushes on every tick until ctx is done, then flushes one last time so no views are lost.
+// Failures are logged and the views retried on the next tick.
+func (w *ViewFlusher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), w.timeout)
+			defer cancel()
+			if err := w.flusher.Flush(flushCtx); err != nil {
+				logrus.Error("failed to flush article views on shutdown: ", err)
+			}
+			return nil
+		case <-ticker.C:
+			if err := w.flusher.Flush(ctx); err != nil {
+				logrus.Error("failed to flush article views: ", err)
+			}
+		}
+	}
+}
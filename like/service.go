@@ -0,0 +1,76 @@
+package like
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// LikeRepository represent the like's repository contract. Like and Unlike are idempotent
+// and return the resulting like count of the article.
+//
+//go:generate mockery --name LikeRepository
+type LikeRepository interface {
+	Like(ctx context.Context, articleID uuid.UUID, userKey string) (int, error)
+	Unlike(ctx context.Context, articleID uuid.UUID, userKey string) (int, error)
+}
+
+// ArticleRepository represent the article's repository contract
+//
+//go:generate mockery --name ArticleRepository
+type ArticleRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Article, error)
+}
+
+type Service struct {
+	likeRepo    LikeRepository
+	articleRepo ArticleRepository
+}
+
+// NewService will create a new like service object
+func NewService(lr LikeRepository, ar ArticleRepository) *Service {
+	return &Service{
+		likeRepo:    lr,
+		articleRepo: ar,
+	}
+}
+
+// Like records that the client identified by userKey likes a live article, liking twice counts once
+func (s *Service) Like(ctx context.Context, articleID uuid.UUID, userKey string) (domain.LikeStatus, error) {
+	if err := s.ensureLive(ctx, articleID); err != nil {
+		return domain.LikeStatus{}, err
+	}
+
+	likes, err := s.likeRepo.Like(ctx, articleID, userKey)
+	if err != nil {
+		return domain.LikeStatus{}, err
+	}
+	return domain.LikeStatus{ArticleID: articleID, Liked: true, Likes: likes}, nil
+}
+
+// Unlike takes back the like of the client identified by userKey, if any
+func (s *Service) Unlike(ctx context.Context, articleID uuid.UUID, userKey string) (domain.LikeStatus, error) {
+	if err := s.ensureLive(ctx, articleID); err != nil {
+		return domain.LikeStatus{}, err
+	}
+
+	likes, err := s.likeRepo.Unlike(ctx, articleID, userKey)
+	if err != nil {
+		return domain.LikeStatus{}, err
+	}
+	return domain.LikeStatus{ArticleID: articleID, Liked: false, Likes: likes}, nil
+}
+
+func (s *Service) ensureLive(ctx context.Context, articleID uuid.UUID) error {
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		return err
+	}
+	if !article.IsVisibleAt(time.Now()) {
+		return domain.ErrNotFound
+	}
+	return nil
+}
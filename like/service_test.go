@@ -0,0 +1,86 @@
+package like
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// memoryLikes keeps likes by article and client the way the repository contract describes
+type memoryLikes struct {
+	likes map[uuid.UUID]map[string]bool
+	calls int
+}
+
+func (r *memoryLikes) Like(_ context.Context, articleID uuid.UUID, userKey string) (int, error) {
+	r.calls++
+	if r.likes[articleID] == nil {
+		r.likes[articleID] = map[string]bool{}
+	}
+	r.likes[articleID][userKey] = true
+	return len(r.likes[articleID]), nil
+}
+
+func (r *memoryLikes) Unlike(_ context.Context, articleID uuid.UUID, userKey string) (int, error) {
+	r.calls++
+	delete(r.likes[articleID], userKey)
+	return len(r.likes[articleID]), nil
+}
+
+// articles returns ErrNotFound for any article it doesn't hold, as trashed ones
+type articles map[uuid.UUID]domain.Article
+
+func (r articles) GetByID(_ context.Context, id uuid.UUID) (domain.Article, error) {
+	article, ok := r[id]
+	if !ok {
+		return domain.Article{}, domain.ErrNotFound
+	}
+	return article, nil
+}
+
+func TestLikeIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	publishedAt := time.Now().Add(-time.Hour)
+	live := domain.Article{ID: uuid.New(), Published: true, PublishedAt: &publishedAt}
+	likes := &memoryLikes{likes: map[uuid.UUID]map[string]bool{}}
+	svc := NewService(likes, articles{live.ID: live})
+
+	for i := 0; i < 2; i++ {
+		status, err := svc.Like(ctx, live.ID, "anon:a")
+		require.NoError(t, err)
+		assert.Equal(t, domain.LikeStatus{ArticleID: live.ID, Liked: true, Likes: 1}, status)
+	}
+	_, err := svc.Like(ctx, live.ID, "anon:b")
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		status, err := svc.Unlike(ctx, live.ID, "anon:a")
+		require.NoError(t, err)
+		assert.Equal(t, domain.LikeStatus{ArticleID: live.ID, Liked: false, Likes: 1}, status)
+	}
+}
+
+func TestLikeRejectsArticlesNotLive(t *testing.T) {
+	ctx := context.Background()
+	draft := domain.Article{ID: uuid.New()}
+	likes := &memoryLikes{likes: map[uuid.UUID]map[string]bool{}}
+	svc := NewService(likes, articles{draft.ID: draft})
+
+	_, err := svc.Like(ctx, draft.ID, "anon:a")
+	assert.Equal(t, domain.ErrNotFound, err)
+	_, err = svc.Unlike(ctx, draft.ID, "anon:a")
+	assert.Equal(t, domain.ErrNotFound, err)
+
+	// Missing and trashed articles are out of the article repository's reach
+	_, err = svc.Like(ctx, uuid.New(), "anon:a")
+	assert.Equal(t, domain.ErrNotFound, err)
+	_, err = svc.Unlike(ctx, uuid.New(), "anon:a")
+	assert.Equal(t, domain.ErrNotFound, err)
+	assert.Zero(t, likes.calls)
+}
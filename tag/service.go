@@ -0,0 +1,80 @@
+package tag
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// TagRepository represent the tag's repository contract
+//
+//go:generate mockery --name TagRepository
+type TagRepository interface {
+	Fetch(ctx context.Context) ([]domain.Tag, error)
+	GetBySlug(ctx context.Context, slug string) (domain.Tag, error)
+	Cloud(ctx context.Context, visibleAt time.Time, limit int) ([]domain.TagCount, error)
+	Update(ctx context.Context, t *domain.Tag) error
+}
+
+type Service struct {
+	tagRepo TagRepository
+}
+
+// NewService will create a new tag service object
+func NewService(tr TagRepository) *Service {
+	return &Service{
+		tagRepo: tr,
+	}
+}
+
+func (s *Service) Fetch(ctx context.Context) ([]domain.Tag, error) {
+	return s.tagRepo.Fetch(ctx)
+}
+
+func (s *Service) GetBySlug(ctx context.Context, slug string) (domain.Tag, error) {
+	return s.tagRepo.GetBySlug(ctx, slug)
+}
+
+// Cloud returns the limit most used tags with the number of published articles using each
+func (s *Service) Cloud(ctx context.Context, limit int) ([]domain.TagCount, error) {
+	return s.tagRepo.Cloud(ctx, time.Now(), limit)
+}
+
+// Rename changes the name of the tag identified by slug on every article, for editors.
+// The tag's slug follows the new name and can't clash with another tag.
+func (s *Service) Rename(ctx context.Context, slug, name string) (domain.Tag, error) {
+	if _, err := domain.RequireRole(ctx, domain.RoleEditor); err != nil {
+		return domain.Tag{}, err
+	}
+
+	name = strings.TrimSpace(name)
+	newSlug := domain.TagSlug(name)
+	if newSlug == "" {
+		return domain.Tag{}, domain.ErrBadParamInput
+	}
+
+	t, err := s.tagRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		return domain.Tag{}, err
+	}
+
+	if newSlug != t.Slug {
+		existing, err := s.tagRepo.GetBySlug(ctx, newSlug)
+		if err != nil && err != domain.ErrNotFound {
+			return domain.Tag{}, err
+		}
+		if err == nil && existing.ID != t.ID {
+			return domain.Tag{}, domain.ErrConflict
+		}
+	}
+
+	t.Name = name
+	t.Slug = newSlug
+	t.UpdatedAt = time.Now()
+	if err = s.tagRepo.Update(ctx, &t); err != nil {
+		return domain.Tag{}, err
+	}
+	return t, nil
+}
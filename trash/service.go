@@ -0,0 +1,94 @@
+package trash
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// ArticleRepository represent the article's trash repository contract
+//
+//go:generate mockery --name ArticleRepository
+type ArticleRepository interface {
+	FetchTrashed(ctx context.Context, page, limit int) ([]domain.Article, error)
+	Restore(ctx context.Context, id uuid.UUID) error
+	PurgeDeletedBefore(ctx context.Context, t time.Time) (int64, error)
+}
+
+// CategoryRepository represent the category's trash repository contract
+//
+//go:generate mockery --name CategoryRepository
+type CategoryRepository interface {
+	FetchTrashed(ctx context.Context, page, limit int) ([]domain.Category, error)
+	Restore(ctx context.Context, id uuid.UUID) error
+	PurgeDeletedBefore(ctx context.Context, t time.Time) (int64, error)
+}
+
+type Service struct {
+	articleRepo  ArticleRepository
+	categoryRepo CategoryRepository
+}
+
+// NewService will create a new trash service object
+func NewService(ar ArticleRepository, cr CategoryRepository) *Service {
+	return &Service{
+		articleRepo:  ar,
+		categoryRepo: cr,
+	}
+}
+
+// Fetch returns a page of the trashed articles and categories, for editors
+func (s *Service) Fetch(ctx context.Context, page, limit int) (res domain.Trash, err error) {
+	if _, err = domain.RequireRole(ctx, domain.RoleEditor); err != nil {
+		return domain.Trash{}, err
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		res.Articles, err = s.articleRepo.FetchTrashed(ctx, page, limit)
+		return err
+	})
+	g.Go(func() (err error) {
+		res.Categories, err = s.categoryRepo.FetchTrashed(ctx, page, limit)
+		return err
+	})
+	if err = g.Wait(); err != nil {
+		return domain.Trash{}, err
+	}
+	return res, nil
+}
+
+// RestoreArticle takes an article out of the trash, for editors
+func (s *Service) RestoreArticle(ctx context.Context, id uuid.UUID) error {
+	if _, err := domain.RequireRole(ctx, domain.RoleEditor); err != nil {
+		return err
+	}
+	return s.articleRepo.Restore(ctx, id)
+}
+
+// RestoreCategory takes a category out of the trash, for editors
+func (s *Service) RestoreCategory(ctx context.Context, id uuid.UUID) error {
+	if _, err := domain.RequireRole(ctx, domain.RoleEditor); err != nil {
+		return err
+	}
+	return s.categoryRepo.Restore(ctx, id)
+}
+
+// Purge hard-deletes everything trashed before the given time and returns how many items went.
+// It is run by the purge job, not on behalf of a user.
+func (s *Service) Purge(ctx context.Context, before time.Time) (int64, error) {
+	articles, err := s.articleRepo.PurgeDeletedBefore(ctx, before)
+	if err != nil {
+		return 0, err
+	}
+
+	categories, err := s.categoryRepo.PurgeDeletedBefore(ctx, before)
+	if err != nil {
+		return articles, err
+	}
+	return articles + categories, nil
+}
@@ -0,0 +1,114 @@
+package view
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+// ViewRepository represent the repository contract storing view counts
+//
+//go:generate mockery --name ViewRepository
+type ViewRepository interface {
+	IncrementViews(ctx context.Context, counts map[uuid.UUID]int) error
+}
+
+// ArticleRepository represent the article's repository contract
+//
+//go:generate mockery --name ArticleRepository
+type ArticleRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (domain.Article, error)
+}
+
+// Service counts article views. Views are deduplicated per client over a time window
+// and buffered in memory until Flush writes them in one batch.
+type Service struct {
+	viewRepo    ViewRepository
+	articleRepo ArticleRepository
+	window      time.Duration
+
+	mu      sync.Mutex
+	seen    map[viewKey]time.Time
+	pending map[uuid.UUID]int
+}
+
+type viewKey struct {
+	articleID   uuid.UUID
+	fingerprint string
+}
+
+// NewService will create a new view service ignoring repeat views of a client within window
+func NewService(vr ViewRepository, ar ArticleRepository, window time.Duration) *Service {
+	return &Service{
+		viewRepo:    vr,
+		articleRepo: ar,
+		window:      window,
+		seen:        make(map[viewKey]time.Time),
+		pending:     make(map[uuid.UUID]int),
+	}
+}
+
+// Record counts a view of a live article by the client identified by fingerprint,
+// unless that client already viewed it within the window
+func (s *Service) Record(ctx context.Context, articleID uuid.UUID, fingerprint string) error {
+	now := time.Now()
+	key := viewKey{articleID: articleID, fingerprint: fingerprint}
+
+	s.mu.Lock()
+	last, ok := s.seen[key]
+	s.mu.Unlock()
+	if ok && now.Sub(last) < s.window {
+		return nil
+	}
+
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		return err
+	}
+	if !article.IsVisibleAt(now) {
+		return domain.ErrNotFound
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Another request of the same client may have been counted in the meantime
+	if last, ok := s.seen[key]; ok && now.Sub(last) < s.window {
+		return nil
+	}
+	s.seen[key] = now
+	s.pending[articleID]++
+	return nil
+}
+
+// Flush writes the buffered views to the repository and forgets clients whose window is over.
+// Views that fail to be written are kept for the next flush.
+func (s *Service) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = make(map[uuid.UUID]int)
+	now := time.Now()
+	for key, last := range s.seen {
+		if now.Sub(last) >= s.window {
+			delete(s.seen, key)
+		}
+	}
+	s.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	if err := s.viewRepo.IncrementViews(ctx, pending); err != nil {
+		s.mu.Lock()
+		for id, n := range pending {
+			s.pending[id] += n
+		}
+		s.mu.Unlock()
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bxcodec/go-clean-arch/domain"
+)
+
+type fakeViewRepo struct {
+	err    error
+	counts map[uuid.UUID]int
+}
+
+func (f *fakeViewRepo) IncrementViews(_ context.Context, counts map[uuid.UUID]int) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.counts = counts
+	return nil
+}
+
+type fakeArticleRepo map[uuid.UUID]domain.Article
+
+func (f fakeArticleRepo) GetByID(_ context.Context, id uuid.UUID) (domain.Article, error) {
+	a, ok := f[id]
+	if !ok {
+		return domain.Article{}, domain.ErrNotFound
+	}
+	return a, nil
+}
+
+func TestRecordAndFlush(t *testing.T) {
+	publishedAt := time.Now().Add(-time.Hour)
+	live := domain.Article{ID: uuid.New(), Published: true, PublishedAt: &publishedAt}
+	draft := domain.Article{ID: uuid.New()}
+
+	viewRepo := &fakeViewRepo{err: errors.New("db down")}
+	s := NewService(viewRepo, fakeArticleRepo{live.ID: live, draft.ID: draft}, time.Hour)
+	ctx := context.Background()
+
+	require.NoError(t, s.Record(ctx, live.ID, "alice"))
+	require.NoError(t, s.Record(ctx, live.ID, "alice"))
+	require.NoError(t, s.Record(ctx, live.ID, "bob"))
+	assert.ErrorIs(t, s.Record(ctx, draft.ID, "alice"), domain.ErrNotFound)
+	assert.ErrorIs(t, s.Record(ctx, uuid.New(), "alice"), domain.ErrNotFound)
+
+	// Failed flushes keep the views for the next one
+	require.Error(t, s.Flush(ctx))
+	viewRepo.err = nil
+	require.NoError(t, s.Flush(ctx))
+	assert.Equal(t, map[uuid.UUID]int{live.ID: 2}, viewRepo.counts)
+
+	viewRepo.counts = nil
+	require.NoError(t, s.Flush(ctx))
+	assert.Nil(t, viewRepo.counts)
+}